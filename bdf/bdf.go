@@ -0,0 +1,364 @@
+// Package bdf converts BDF bitmap fonts to FIGfonts
+//
+// Each pixel of a glyph bitmap becomes a fill character, or with half
+// blocks two vertical pixels become one of the chars ' ', '▀', '▄' and '█'.
+// The resulting .flf can be loaded with LoadFont of figlet4go.
+package bdf
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/HoldenLucas/figlet4go"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Glyph is a single char of a BDF font
+type Glyph struct {
+	// Name given in STARTCHAR
+	Name string
+	// Code of the char (ENCODING)
+	Encoding rune
+	// Horizontal advance in pixels (DWIDTH)
+	Advance int
+	// Bounding box of the bitmap: size and offset to the origin (BBX)
+	Width, Height, XOffset, YOffset int
+	// Rows of the bitmap from top to bottom, true for set pixels
+	Bitmap [][]bool
+}
+
+// Font is a parsed BDF font
+type Font struct {
+	// Name given in FONT
+	Name string
+	// Font bounding box (FONTBOUNDINGBOX)
+	Width, Height, XOffset, YOffset int
+	// Pixels above the baseline (FONT_ASCENT)
+	Ascent int
+	// Glyphs by encoding, glyphs without an encoding are skipped
+	Glyphs map[rune]*Glyph
+}
+
+// Options of the conversion to a FIGfont
+type Options struct {
+	// Char used for set pixels, ignored with HalfBlocks
+	Fill rune
+	// Combine two pixel rows into one line with half block chars
+	HalfBlocks bool
+	// Comment lines written to the font header
+	Comment string
+}
+
+// NewOptions creates Options with '#' as fill char
+func NewOptions() *Options {
+	return &Options{Fill: '#'}
+}
+
+// Parse reads a BDF font
+func Parse(r io.Reader) (*Font, error) {
+	f := &Font{Glyphs: make(map[rune]*Glyph), Ascent: -1}
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	var glyph *Glyph
+	inBitmap := false
+
+	for scanner.Scan() {
+		lineNum++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		fail := func(msg string) error {
+			return fmt.Errorf("bdf: line %d: %s", lineNum, msg)
+		}
+
+		// Bitmap rows are hex numbers up to ENDCHAR
+		if inBitmap && fields[0] != "ENDCHAR" {
+			row, err := parseBitmapRow(fields[0], glyph.Width)
+			if err != nil {
+				return nil, fail(err.Error())
+			}
+			glyph.Bitmap = append(glyph.Bitmap, row)
+			continue
+		}
+
+		switch fields[0] {
+		case "FONT":
+			f.Name = strings.Join(fields[1:], " ")
+
+		case "FONTBOUNDINGBOX":
+			nums, err := parseInts(fields[1:], 4)
+			if err == nil {
+				err = checkBox(nums)
+			}
+			if err != nil {
+				return nil, fail(err.Error())
+			}
+			f.Width, f.Height, f.XOffset, f.YOffset = nums[0], nums[1], nums[2], nums[3]
+
+		case "FONT_ASCENT":
+			nums, err := parseInts(fields[1:], 1)
+			if err != nil {
+				return nil, fail(err.Error())
+			}
+			f.Ascent = nums[0]
+
+		case "STARTCHAR":
+			glyph = &Glyph{Name: strings.Join(fields[1:], " "), Encoding: -1}
+
+		case "ENCODING":
+			if glyph == nil {
+				return nil, fail("ENCODING outside of a char")
+			}
+			nums, err := parseInts(fields[1:], 1)
+			if err != nil {
+				return nil, fail(err.Error())
+			}
+			glyph.Encoding = rune(nums[0])
+
+		case "DWIDTH":
+			if glyph == nil {
+				return nil, fail("DWIDTH outside of a char")
+			}
+			nums, err := parseInts(fields[1:], 1)
+			if err == nil && (nums[0] < -maxPixels || nums[0] > maxPixels) {
+				err = fmt.Errorf("width %d out of range", nums[0])
+			}
+			if err != nil {
+				return nil, fail(err.Error())
+			}
+			glyph.Advance = nums[0]
+
+		case "BBX":
+			if glyph == nil {
+				return nil, fail("BBX outside of a char")
+			}
+			nums, err := parseInts(fields[1:], 4)
+			if err == nil {
+				err = checkBox(nums)
+			}
+			if err != nil {
+				return nil, fail(err.Error())
+			}
+			glyph.Width, glyph.Height, glyph.XOffset, glyph.YOffset = nums[0], nums[1], nums[2], nums[3]
+
+		case "BITMAP":
+			if glyph == nil {
+				return nil, fail("BITMAP outside of a char")
+			}
+			inBitmap = true
+
+		case "ENDCHAR":
+			if glyph == nil {
+				return nil, fail("ENDCHAR outside of a char")
+			}
+			if len(glyph.Bitmap) != glyph.Height {
+				return nil, fail(fmt.Sprintf("char %s has %d bitmap rows, expected %d", glyph.Name, len(glyph.Bitmap), glyph.Height))
+			}
+			if glyph.Encoding >= 0 {
+				f.Glyphs[glyph.Encoding] = glyph
+			}
+			glyph = nil
+			inBitmap = false
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if f.Height <= 0 {
+		return nil, errors.New("bdf: missing FONTBOUNDINGBOX")
+	}
+	// Without FONT_ASCENT the bounding box is above the baseline
+	if f.Ascent < 0 {
+		f.Ascent = f.Height + f.YOffset
+	}
+
+	return f, nil
+}
+
+// Parse a hex bitmap row, the bits are padded to full bytes
+func parseBitmapRow(hex string, width int) ([]bool, error) {
+	row := make([]bool, width)
+	for i, c := range hex {
+		nibble, err := strconv.ParseUint(string(c), 16, 8)
+		if err != nil {
+			return nil, errors.New("invalid bitmap row: " + hex)
+		}
+		for bit := 0; bit < 4; bit++ {
+			x := i*4 + bit
+			if x < width && nibble&(8>>bit) != 0 {
+				row[x] = true
+			}
+		}
+	}
+	return row, nil
+}
+
+// Largest size and offset of a bounding box in pixels,
+// bigger ones are rejected instead of allocated
+const maxPixels int = 1024
+
+// Check a bounding box of width, height and the offsets
+func checkBox(nums []int) error {
+	if nums[0] < 0 || nums[0] > maxPixels || nums[1] < 0 || nums[1] > maxPixels {
+		return fmt.Errorf("size %dx%d out of range", nums[0], nums[1])
+	}
+	if nums[2] < -maxPixels || nums[2] > maxPixels || nums[3] < -maxPixels || nums[3] > maxPixels {
+		return fmt.Errorf("offset %d %d out of range", nums[2], nums[3])
+	}
+	return nil
+}
+
+// Parse at least n integer fields
+func parseInts(fields []string, n int) ([]int, error) {
+	if len(fields) < n {
+		return nil, fmt.Errorf("expected %d numbers", n)
+	}
+	nums := make([]int, n)
+	for i := range nums {
+		num, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return nil, errors.New("invalid number: " + fields[i])
+		}
+		nums[i] = num
+	}
+	return nums, nil
+}
+
+// Pixels of a glyph placed in the font bounding box
+// The grid has the font height and the advance of the glyph as width
+func (f *Font) pixels(g *Glyph) [][]bool {
+	width := max(g.Advance, g.XOffset+g.Width, 0)
+	grid := make([][]bool, f.Height)
+	for y := range grid {
+		grid[y] = make([]bool, width)
+	}
+
+	// Row of the font box where the top of the bitmap is
+	top := f.Ascent - (g.YOffset + g.Height)
+	for y, row := range g.Bitmap {
+		gy := top + y
+		if gy < 0 || gy >= f.Height {
+			continue
+		}
+		for x, set := range row {
+			gx := g.XOffset + x
+			if set && gx >= 0 && gx < width {
+				grid[gy][gx] = true
+			}
+		}
+	}
+
+	return grid
+}
+
+// Lines of a glyph in the FIGfont
+func (f *Font) glyphLines(g *Glyph, opt *Options) []string {
+	grid := f.pixels(g)
+
+	if !opt.HalfBlocks {
+		lines := make([]string, len(grid))
+		for y, row := range grid {
+			var b strings.Builder
+			for _, set := range row {
+				if set {
+					b.WriteRune(opt.Fill)
+				} else {
+					b.WriteByte(' ')
+				}
+			}
+			lines[y] = b.String()
+		}
+		return lines
+	}
+
+	// Two pixel rows per line
+	blocks := [2][2]rune{{' ', '▄'}, {'▀', '█'}}
+	lines := make([]string, (len(grid)+1)/2)
+	for i := range lines {
+		var b strings.Builder
+		upper := grid[i*2]
+		for x := range upper {
+			lower := false
+			if i*2+1 < len(grid) {
+				lower = grid[i*2+1][x]
+			}
+			b.WriteRune(blocks[btoi(upper[x])][btoi(lower)])
+		}
+		lines[i] = b.String()
+	}
+	return lines
+}
+
+// 1 for true, 0 for false
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// FIGfont converts the font to a FIGfont
+// Chars beyond the required ones get the glyph name as code tag description
+func (f *Font) FIGfont(opt *Options) (*figlet4go.Font, error) {
+	height := f.Height
+	baseline := f.Ascent
+	if opt.HalfBlocks {
+		height = (height + 1) / 2
+		baseline = (baseline + 1) / 2
+	}
+
+	// Full width layout keeps the spacing of the bitmap font
+	fig := figlet4go.NewFont(height)
+	fig.Baseline = baseline
+	// The hardblank must differ from the fill char
+	if !opt.HalfBlocks && opt.Fill == '$' {
+		fig.Hardblank = "%"
+	}
+
+	fig.Comments = []string{"Converted from the BDF font " + f.Name}
+	if opt.Comment != "" {
+		fig.Comments = strings.Split(strings.TrimRight(opt.Comment, "\n"), "\n")
+	}
+
+	// Without a space glyph the space gets half of the font width
+	if _, ok := f.Glyphs[' ']; !ok {
+		space := f.glyphLines(&Glyph{Advance: f.Width / 2}, opt)
+		if err := fig.SetChar(' ', space, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	for code, g := range f.Glyphs {
+		description := ""
+		if !isRequired(code) {
+			description = g.Name
+		}
+		if err := fig.SetChar(code, f.glyphLines(g, opt), description); err != nil {
+			return nil, err
+		}
+	}
+
+	return fig, nil
+}
+
+// Check if a char is one of the required chars of a FIGfont,
+// the printable ascii chars and the german chars
+func isRequired(code rune) bool {
+	return (code >= 32 && code <= 126) || strings.ContainsRune("ÄÖÜäöüß", code)
+}
+
+// WriteFLF writes the font as a FIGfont
+// Chars missing in the BDF font are written empty
+func (f *Font) WriteFLF(w io.Writer, opt *Options) error {
+	fig, err := f.FIGfont(opt)
+	if err != nil {
+		return err
+	}
+	return fig.WriteFLF(w)
+}
@@ -0,0 +1,166 @@
+package bdf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/HoldenLucas/figlet4go"
+)
+
+// A 4x4 font with an ascent of 3 containing 'A', '_' and a code tagged heart
+const testBDF = `STARTFONT 2.1
+FONT -test-tiny-medium-r-normal--4-40-75-75-c-40-iso10646-1
+SIZE 4 75 75
+FONTBOUNDINGBOX 4 4 0 -1
+STARTPROPERTIES 2
+FONT_ASCENT 3
+FONT_DESCENT 1
+ENDPROPERTIES
+CHARS 3
+STARTCHAR A
+ENCODING 65
+SWIDTH 1000 0
+DWIDTH 4 0
+BBX 3 3 0 0
+BITMAP
+40
+E0
+A0
+ENDCHAR
+STARTCHAR underscore
+ENCODING 95
+DWIDTH 4 0
+BBX 4 1 0 -1
+BITMAP
+F0
+ENDCHAR
+STARTCHAR heart
+ENCODING 9829
+DWIDTH 4 0
+BBX 3 2 0 1
+BITMAP
+A0
+40
+ENDCHAR
+ENDFONT
+`
+
+func convert(t *testing.T, opt *Options) *figlet4go.AsciiRender {
+	t.Helper()
+
+	f, err := Parse(strings.NewReader(testBDF))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := f.WriteFLF(&buf, opt); err != nil {
+		t.Fatal(err)
+	}
+
+	ascii := figlet4go.NewAsciiRender()
+	if err := ascii.LoadBindataFont(buf.Bytes(), "tiny"); err != nil {
+		t.Fatal(err)
+	}
+	return ascii
+}
+
+func render(t *testing.T, ascii *figlet4go.AsciiRender, str string) string {
+	t.Helper()
+
+	opt := figlet4go.NewRenderOptions()
+	opt.FontName = "tiny"
+	out, err := ascii.RenderOpts(str, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestConvert(t *testing.T) {
+	ascii := convert(t, NewOptions())
+
+	// The heart is code tagged after the required chars
+	got := render(t, ascii, "A_♥")
+	want := "" +
+		" #      # # \n" +
+		"###      #  \n" +
+		"# #         \n" +
+		"    ####    \n"
+	if got != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestConvertRequired(t *testing.T) {
+	f, err := Parse(strings.NewReader(testBDF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	umlaut := *f.Glyphs['A']
+	umlaut.Name = "adieresis"
+	umlaut.Encoding = 'ä'
+	f.Glyphs['ä'] = &umlaut
+
+	var buf bytes.Buffer
+	if err := f.WriteFLF(&buf, NewOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The german chars are required, only the heart is code tagged
+	out := buf.String()
+	if strings.Contains(out, "adieresis") || !strings.Contains(out, "\n9829  heart\n") {
+		t.Errorf("unexpected code tags:\n%s", out)
+	}
+}
+
+func TestConvertHalfBlocks(t *testing.T) {
+	opt := NewOptions()
+	opt.HalfBlocks = true
+	ascii := convert(t, opt)
+
+	got := render(t, ascii, "A_")
+	want := "" +
+		"▄█▄     \n" +
+		"▀ ▀ ▄▄▄▄\n"
+	if got != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestConvertFillEndmark(t *testing.T) {
+	opt := NewOptions()
+	opt.Fill = '@'
+	ascii := convert(t, opt)
+
+	got := render(t, ascii, "_")
+	if want := "    \n    \n    \n@@@@\n"; got != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	broken := strings.Replace(testBDF, "E0\n", "", 1)
+	if _, err := Parse(strings.NewReader(broken)); err == nil {
+		t.Error("expected an error for missing bitmap rows")
+	}
+	if _, err := Parse(strings.NewReader("STARTFONT 2.1\nENDFONT\n")); err == nil {
+		t.Error("expected an error for a missing bounding box")
+	}
+
+	// Sizes which would panic or allocate too much
+	for _, bad := range []struct{ old, new string }{
+		{"BBX 3 3 0 0", "BBX -3 3 0 0"},
+		{"BBX 3 3 0 0", "BBX 3 -3 0 0"},
+		{"BBX 3 3 0 0", "BBX 3 3 100000000 0"},
+		{"FONTBOUNDINGBOX 4 4 0 -1", "FONTBOUNDINGBOX 4 -4 0 -1"},
+		{"FONTBOUNDINGBOX 4 4 0 -1", "FONTBOUNDINGBOX 4 100000000 0 -1"},
+		{"DWIDTH 4 0", "DWIDTH 100000000 0"},
+	} {
+		broken := strings.Replace(testBDF, bad.old, bad.new, 1)
+		if _, err := Parse(strings.NewReader(broken)); err == nil {
+			t.Errorf("%s: expected an error", bad.new)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package figlet4go
+
+import (
+	"container/list"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CacheStats are the counters of the render cache
+type CacheStats struct {
+	// Renders returned from the cache
+	Hits uint64
+	// Renders not found in the cache
+	Misses uint64
+	// Results removed to stay within the limits
+	Evictions uint64
+	// Number of cached results
+	Entries int
+	// Size of the cached results and their keys in bytes
+	Bytes int
+}
+
+// Least recently used cache of render results
+type renderCache struct {
+	mu sync.Mutex
+	// Limits, 0 for no limit
+	maxEntries int
+	maxBytes   int
+	// Entries with the most recently used at the front
+	entries *list.List
+	// Elements of the entries by key
+	index map[string]*list.Element
+	stats CacheStats
+	// Incremented by clear, results rendered before are not added
+	generation uint64
+}
+
+// A cached render result
+type cacheEntry struct {
+	key    string
+	result string
+}
+
+// Create an empty cache with the given limits
+func newRenderCache(maxEntries, maxBytes int) *renderCache {
+	return &renderCache{
+		maxEntries: max(maxEntries, 0),
+		maxBytes:   max(maxBytes, 0),
+		entries:    list.New(),
+		index:      make(map[string]*list.Element),
+	}
+}
+
+// Get a cached result and mark it as recently used
+// On a miss the current generation is returned to add the result
+func (c *renderCache) get(key string) (string, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.index[key]
+	if !ok {
+		c.stats.Misses++
+		return "", c.generation, false
+	}
+	c.stats.Hits++
+	c.entries.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).result, c.generation, true
+}
+
+// Add a result, the least recently used results are evicted
+// if the limits are exceeded
+// Results larger than the byte limit or rendered before
+// the cache was cleared (an older generation) are not cached
+func (c *renderCache) add(key, result string, generation uint64) {
+	size := len(key) + len(result)
+	if c.maxBytes > 0 && size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	// Another goroutine may have rendered it in the meantime
+	if elem, ok := c.index[key]; ok {
+		c.entries.MoveToFront(elem)
+		return
+	}
+
+	c.index[key] = c.entries.PushFront(&cacheEntry{key, result})
+	c.stats.Entries++
+	c.stats.Bytes += size
+
+	for (c.maxEntries > 0 && c.stats.Entries > c.maxEntries) || (c.maxBytes > 0 && c.stats.Bytes > c.maxBytes) {
+		c.removeElement(c.entries.Back())
+		c.stats.Evictions++
+	}
+}
+
+// Remove all results, the counters are kept
+func (c *renderCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries.Init()
+	c.index = make(map[string]*list.Element)
+	c.generation++
+	c.stats.Entries = 0
+	c.stats.Bytes = 0
+}
+
+// Remove a single entry, the caller must hold c.mu
+func (c *renderCache) removeElement(elem *list.Element) {
+	entry := c.entries.Remove(elem).(*cacheEntry)
+	delete(c.index, entry.key)
+	c.stats.Entries--
+	c.stats.Bytes -= len(entry.key) + len(entry.result)
+}
+
+// Get the counters
+func (c *renderCache) statistics() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// Key of a color, an AnsiColor has other terminal
+// codes than a TrueColor with the same value
+func colorKey(c Color) string {
+	if _, ok := c.(AnsiColor); ok {
+		return "ansi:" + colorHex(c)
+	}
+	return colorHex(c)
+}
+
+// Build the cache key of a render from the text and all
+// options which change the result
+func cacheKey(str string, opt *RenderOptions) string {
+	var b strings.Builder
+
+	// Fields are separated by a zero byte, strings are length prefixed
+	// so no field can contain the start of the next one
+	field := func(s string) {
+		b.WriteString(strconv.Itoa(len(s)))
+		b.WriteByte(':')
+		b.WriteString(s)
+		b.WriteByte(0)
+	}
+
+	field(str)
+	field(opt.FontName)
+	field(strconv.FormatBool(opt.Fallback))
+	field(strconv.Itoa(opt.Width))
+	field(strconv.Itoa(int(opt.Layout)))
+
+	p := opt.Parser
+	field(p.Name)
+	field(p.Prefix)
+	field(p.Suffix)
+	field(p.LinePrefix)
+	field(p.LineSuffix)
+	// Registering a parser again may change its functions
+	field(strconv.Itoa(getParserFuncs(p).version))
+	olds := make([]string, 0, len(p.Replaces))
+	for old := range p.Replaces {
+		olds = append(olds, old)
+	}
+	sort.Strings(olds)
+	field(strconv.Itoa(len(olds)))
+	for _, old := range olds {
+		field(old)
+		field(p.Replaces[old])
+	}
+
+	// Colors are keyed by their value, the codes for the parser
+	// are empty for parsers formatting the colors themselves
+	field(strconv.Itoa(len(opt.FontColor)))
+	for _, c := range opt.FontColor {
+		field(colorKey(c))
+	}
+
+	// Controls can't be changed after parsing, the id of a
+	// control is never reused unlike its address
+	field(strconv.Itoa(len(opt.Controls)))
+	for _, c := range opt.Controls {
+		field(strconv.FormatUint(c.id, 10))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,187 @@
+package figlet4go
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+)
+
+func TestRenderCache(t *testing.T) {
+	ascii := NewAsciiRender()
+	ascii.EnableCache(2, 0)
+	opt := NewRenderOptions()
+
+	render := func(str string) string {
+		out, err := ascii.RenderOpts(str, opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	a := render("a")
+	if render("a") != a {
+		t.Error("cached result differs")
+	}
+	render("b")
+	// Evicts "a", the least recently used
+	render("c")
+	render("a")
+
+	want := CacheStats{Hits: 1, Misses: 4, Evictions: 2, Entries: 2}
+	got := ascii.CacheStats()
+	want.Bytes = got.Bytes
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	// Options changing the result are part of the key
+	opt.FontColor = []Color{ColorRed}
+	if render("a") == a {
+		t.Error("expected a colored result")
+	}
+	opt.FontColor = nil
+	opt.Parser = getParser(t, "html")
+	if render("a") == a {
+		t.Error("expected a html result")
+	}
+}
+
+func TestRenderCacheColors(t *testing.T) {
+	for _, name := range []string{"terminal", "html-pre", "markdown-html", "json", "json-runs", "irc"} {
+		t.Run(name, func(t *testing.T) {
+			ascii := NewAsciiRender()
+			ascii.EnableCache(10, 0)
+			opt := NewRenderOptions()
+			opt.Parser = getParser(t, name)
+
+			for _, c := range []Color{ColorRed, ColorBlue, TrueColor{0, 116, 217}} {
+				opt.FontColor = []Color{c}
+				got, err := ascii.RenderOpts("Hi", opt)
+				if err != nil {
+					t.Fatal(err)
+				}
+				want, _ := NewAsciiRender().RenderOpts("Hi", opt)
+				if got != want {
+					t.Errorf("%v: got a cached result of another color", c)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderCacheControls(t *testing.T) {
+	ascii := NewAsciiRender()
+	ascii.EnableCache(10, 0)
+	opt := NewRenderOptions()
+
+	// Controls with the same content are parsed into different controls,
+	// each is cached with its own translation
+	for _, to := range []string{"b", "c", "c"} {
+		control, err := ParseControl(strings.NewReader("t a " + to + "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		opt.Controls = []*Control{control}
+		got, err := ascii.RenderOpts("a", opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want, _ := ascii.render(to, NewRenderOptions()); got != want {
+			t.Errorf("a translated to %s: got\n%s\nwant:\n%s", to, got, want)
+		}
+	}
+	if stats := ascii.CacheStats(); stats.Misses != 3 {
+		t.Errorf("got %d misses, want 3", stats.Misses)
+	}
+
+	// The number of controls is part of the key
+	control := opt.Controls[0]
+	opt.Controls = []*Control{control, control}
+	key := cacheKey("a", opt)
+	opt.Controls = []*Control{control}
+	if cacheKey("a", opt) == key {
+		t.Error("same key for one and two controls")
+	}
+}
+
+func TestRenderCacheBytes(t *testing.T) {
+	ascii := NewAsciiRender()
+	ascii.EnableCache(0, 2000)
+
+	for i := range 20 {
+		if _, err := ascii.RenderOpts(strconv.Itoa(i), NewRenderOptions()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats := ascii.CacheStats()
+	if stats.Bytes > 2000 || stats.Entries == 0 || stats.Evictions == 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestRenderCacheLoadFont(t *testing.T) {
+	ascii := NewAsciiRender()
+	ascii.EnableCache(10, 0)
+
+	opt := NewRenderOptions()
+	opt.FontName = "other"
+	opt.Fallback = true
+	fallback, _ := ascii.RenderOpts("a", opt)
+
+	// Loading a font with the name replaces the cached fallback
+	fsys := fstest.MapFS{"other.tlf": {Data: []byte(testToiletFont())}}
+	if err := ascii.LoadFontFS(fsys, "."); err != nil {
+		t.Fatal(err)
+	}
+	if out, _ := ascii.RenderOpts("a", opt); out == fallback {
+		t.Error("got the result cached before loading the font")
+	}
+	if stats := ascii.CacheStats(); stats.Entries != 1 {
+		t.Errorf("expected only the new result, got %+v", stats)
+	}
+}
+
+func TestRenderCacheConcurrent(t *testing.T) {
+	ascii := NewAsciiRender()
+	ascii.EnableCache(5, 0)
+	want, _ := NewAsciiRender().Render("x")
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				got, err := ascii.Render("x")
+				if err != nil || got != want {
+					t.Errorf("unexpected result %q, %v", got, err)
+					return
+				}
+				ascii.Render(strconv.Itoa(i*100 + j))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stats := ascii.CacheStats(); stats.Entries > 5 || stats.Hits == 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func BenchmarkRenderCached(b *testing.B) {
+	ascii := NewAsciiRender()
+	ascii.EnableCache(100, 0)
+	opt := NewRenderOptions()
+	opt.FontColor = []Color{ColorRed, TrueColor{0, 255, 0}}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := ascii.RenderOpts("The quick brown fox jumps over the lazy dog", opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
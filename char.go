@@ -1,54 +1,99 @@
 package figlet4go
 
 import (
-	"errors"
+	"sort"
+	"strconv"
 	"strings"
 )
 
 // Represents a single ascii character
 type asciiChar struct {
-	// Slice with the lines of the Char
+	// Slice with the lines of the Char, shared with the font
 	Lines []string
+	// Width of the lines in display columns
+	Width int
 	// Color of the char
 	Color Color
+	// Glyph the lines are from, used for kerning and smushing
+	Glyph *glyph
+}
+
+// ErrCharNotInFont is returned if a char of the text has no glyph
+// in the font and the font has no glyph for missing chars
+type ErrCharNotInFont struct {
+	// The missing char
+	Char rune
+}
+
+func (e *ErrCharNotInFont) Error() string {
+	return "Char not in font: " + strconv.QuoteRune(e.Char)
 }
 
 // Creates a new ascii character
-func newAsciiChar(font *font, char rune) (*asciiChar, error) {
-	// If not ascii, throw an error
-	if char < 0 || char > 127 {
-		return nil, errors.New("Not Ascii")
+func newAsciiChar(font *Font, char rune) (asciiChar, error) {
+	// Get the font's representation of the char
+	g, ok := font.glyph(char)
+	// If the font doesn't contain the char, throw an error
+	if !ok {
+		return asciiChar{}, &ErrCharNotInFont{char}
 	}
 
-	// Get the font's representation of the char
-	lines := font.getCharSlice(char)
+	return asciiChar{Lines: g.rows, Width: g.width, Glyph: g}, nil
+}
 
-	return &asciiChar{Lines: lines}, nil
+// Pre- and suffix of a color for a parser
+type colorCode struct {
+	prefix string
+	suffix string
 }
 
-// Return a line of the char as string with color if set
-func (char *asciiChar) GetLine(index int, p Parser) string {
-	prefix := ""
-	suffix := ""
+// Get the codes of all colors for a parser once per render
+func colorCodes(colors []Color, p Parser) map[Color]colorCode {
+	codes := make(map[Color]colorCode, len(colors))
+	for _, c := range colors {
+		codes[c] = colorCode{c.getPrefix(p), c.getSuffix(p)}
+	}
+	return codes
+}
 
-	line := handleReplaces(char.Lines[index], p)
+// Write a line of the char with color if set
+// The line is escaped by the parser first, then the replacer does
+// the parser specific replaces (see newReplacer)
+func (char *asciiChar) writeLine(w *strings.Builder, index int, escape func(string) string, r *strings.Replacer, codes map[Color]colorCode) {
+	line := char.Lines[index]
+	if escape != nil {
+		line = escape(line)
+	}
 
-	if char.Color != nil {
-		prefix = char.Color.getPrefix(p)
-		suffix = char.Color.getSuffix(p)
+	if char.Color == nil {
+		r.WriteString(w, line)
+		return
 	}
 
-	return prefix + line + suffix
+	code := codes[char.Color]
+	w.WriteString(code.prefix)
+	r.WriteString(w, line)
+	w.WriteString(code.suffix)
 }
 
-// Replace all parser specific things
-func handleReplaces(str string, p Parser) string {
-	if p.Replaces == nil {
-		return str
+// Create a replacer for all parser specific things
+// Longer strings are replaced first so the result doesn't
+// depend on the order of the map
+func newReplacer(p Parser) *strings.Replacer {
+	olds := make([]string, 0, len(p.Replaces))
+	for old := range p.Replaces {
+		olds = append(olds, old)
 	}
-	// Replace for each entry
-	for old, new := range p.Replaces {
-		str = strings.Replace(str, old, new, -1)
+	sort.Slice(olds, func(i, j int) bool {
+		if len(olds[i]) != len(olds[j]) {
+			return len(olds[i]) > len(olds[j])
+		}
+		return olds[i] < olds[j]
+	})
+
+	pairs := make([]string, 0, 2*len(olds))
+	for _, old := range olds {
+		pairs = append(pairs, old, p.Replaces[old])
 	}
-	return str
+	return strings.NewReplacer(pairs...)
 }
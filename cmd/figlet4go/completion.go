@@ -0,0 +1,245 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Generate shell completion scripts
+var completionCommand = &command{
+	name:  "completion",
+	args:  "<bash|zsh|fish>",
+	short: "Print a shell completion script",
+	setup: func(fs *flag.FlagSet) func(args []string) error {
+		return func(args []string) error {
+			if len(args) != 1 {
+				return usageError{"expected exactly one shell"}
+			}
+
+			switch args[0] {
+			case "bash":
+				writeBashCompletion(os.Stdout)
+			case "zsh":
+				writeZshCompletion(os.Stdout)
+			case "fish":
+				writeFishCompletion(os.Stdout)
+			default:
+				return usageError{"unsupported shell: " + args[0]}
+			}
+			return nil
+		}
+	},
+}
+
+// Flags which take a file or directory
+var fileFlags = map[string]bool{
+	"control":  true,
+	"fontpath": true,
+	"file":     true,
+	"input":    true,
+	"o":        true,
+}
+
+// Command line to list the font names
+const fontNamesCmd string = "figlet4go fonts list -names 2>/dev/null"
+
+// A command with its path for the completion scripts
+type completionEntry struct {
+	// Command names without the program name
+	path []string
+	// Names of the subcommands
+	subcommands []string
+	// Flags of the command
+	flags []*flag.Flag
+}
+
+// Collect all commands recursively
+func completionEntries() []completionEntry {
+	entries := []completionEntry{}
+
+	var walk func(parents []string, cmds []*command)
+	walk = func(parents []string, cmds []*command) {
+		for _, c := range cmds {
+			entry := completionEntry{path: append(append([]string{}, parents...), c.name)}
+			for _, sub := range c.subcommands {
+				entry.subcommands = append(entry.subcommands, sub.name)
+			}
+			if c.setup != nil {
+				fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+				c.setup(fs)
+				fs.VisitAll(func(f *flag.Flag) {
+					entry.flags = append(entry.flags, f)
+				})
+			}
+			entries = append(entries, entry)
+			walk(entry.path, c.subcommands)
+		}
+	}
+	walk(nil, commands)
+
+	return entries
+}
+
+// Names of the top level commands
+func commandNames() []string {
+	names := []string{}
+	for _, c := range commands {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+// Whether the flag doesn't take a value
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// Words completed for a command, subcommands or flags
+func completionWords(e completionEntry) string {
+	words := append([]string{}, e.subcommands...)
+	for _, f := range e.flags {
+		words = append(words, "-"+f.Name)
+	}
+	return strings.Join(words, " ")
+}
+
+// Case patterns of all command paths, f.e. " fonts| fonts list"
+func completionPaths(entries []completionEntry) string {
+	paths := []string{}
+	for _, e := range entries {
+		paths = append(paths, `" `+strings.Join(e.path, " ")+`"`)
+	}
+	sort.Strings(paths)
+	return strings.Join(paths, "|")
+}
+
+// Names of the flags taking files as a case pattern
+func fileFlagPattern() string {
+	names := []string{}
+	for name := range fileFlags {
+		names = append(names, "-"+name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, "|")
+}
+
+// Write the bash completion script
+func writeBashCompletion(w io.Writer) {
+	entries := completionEntries()
+
+	fmt.Fprintf(w, `# bash completion for figlet4go
+# Load with: source <(figlet4go completion bash)
+_figlet4go() {
+	local cur="${COMP_WORDS[COMP_CWORD]}" prev="${COMP_WORDS[COMP_CWORD-1]}"
+	local cmdpath="" w words=""
+
+	# Find the (sub)command in the words before the current one
+	for w in "${COMP_WORDS[@]:1:COMP_CWORD-1}"; do
+		case "$cmdpath $w" in
+			%s) cmdpath="$cmdpath $w" ;;
+		esac
+	done
+
+	case "$prev" in
+		-font) COMPREPLY=($(compgen -W "$(%s)" -- "$cur")); return ;;
+		%s) COMPREPLY=($(compgen -f -- "$cur")); return ;;
+	esac
+
+	case "$cmdpath" in
+		"") words="%s" ;;
+`, completionPaths(entries), fontNamesCmd, fileFlagPattern(), strings.Join(commandNames(), " "))
+
+	for _, e := range entries {
+		fmt.Fprintf(w, "\t\t\" %s\") words=\"%s\" ;;\n", strings.Join(e.path, " "), completionWords(e))
+	}
+
+	fmt.Fprint(w, `	esac
+	COMPREPLY=($(compgen -W "$words" -- "$cur"))
+}
+complete -F _figlet4go figlet4go
+`)
+}
+
+// Write the zsh completion script
+func writeZshCompletion(w io.Writer) {
+	entries := completionEntries()
+
+	fmt.Fprintf(w, `#compdef figlet4go
+# zsh completion for figlet4go
+# Load with: source <(figlet4go completion zsh)
+_figlet4go() {
+	local prev="${words[CURRENT-1]}" cmdpath="" w
+
+	# Find the (sub)command in the words before the current one
+	for w in "${(@)words[2,CURRENT-1]}"; do
+		case "$cmdpath $w" in
+			%s) cmdpath="$cmdpath $w" ;;
+		esac
+	done
+
+	case "$prev" in
+		-font) compadd -- ${(f)"$(%s)"}; return ;;
+		%s) _files; return ;;
+	esac
+
+	case "$cmdpath" in
+		"") compadd -- %s ;;
+`, completionPaths(entries), fontNamesCmd, fileFlagPattern(), strings.Join(commandNames(), " "))
+
+	for _, e := range entries {
+		fmt.Fprintf(w, "\t\t\" %s\") compadd -- %s ;;\n", strings.Join(e.path, " "), completionWords(e))
+	}
+
+	fmt.Fprint(w, `	esac
+}
+compdef _figlet4go figlet4go
+`)
+}
+
+// Write the fish completion script
+func writeFishCompletion(w io.Writer) {
+	fmt.Fprint(w, `# fish completion for figlet4go
+# Load with: figlet4go completion fish | source
+complete -c figlet4go -f
+`)
+
+	for _, c := range commands {
+		fmt.Fprintf(w, "complete -c figlet4go -n __fish_use_subcommand -a %s -d %q\n", c.name, c.short)
+	}
+
+	for _, e := range completionEntries() {
+		// All names of the path must be given
+		conds := []string{}
+		for _, name := range e.path {
+			conds = append(conds, "__fish_seen_subcommand_from "+name)
+		}
+		cond := strings.Join(conds, "; and ")
+
+		if len(e.subcommands) > 0 {
+			cond += "; and not __fish_seen_subcommand_from " + strings.Join(e.subcommands, " ")
+		}
+
+		for _, sub := range e.subcommands {
+			fmt.Fprintf(w, "complete -c figlet4go -n %q -a %s\n", cond, sub)
+		}
+
+		for _, f := range e.flags {
+			usage := strings.SplitN(f.Usage, "\n", 2)[0]
+			switch {
+			case f.Name == "font":
+				fmt.Fprintf(w, "complete -c figlet4go -n %q -o %s -x -a %q -d %q\n", cond, f.Name, "("+fontNamesCmd+")", usage)
+			case fileFlags[f.Name]:
+				fmt.Fprintf(w, "complete -c figlet4go -n %q -o %s -r -F -d %q\n", cond, f.Name, usage)
+			case isBoolFlag(f):
+				fmt.Fprintf(w, "complete -c figlet4go -n %q -o %s -d %q\n", cond, f.Name, usage)
+			default:
+				fmt.Fprintf(w, "complete -c figlet4go -n %q -o %s -x -d %q\n", cond, f.Name, usage)
+			}
+		}
+	}
+}
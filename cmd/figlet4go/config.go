@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+)
+
+// Settings which can have defaults in the config file or the environment
+var configKeys = []string{"font", "fontpath", "colors", "parser"}
+
+// Environment variables for each setting, the first one set wins
+// FIGLET_FONTDIR is also read by figlet
+var configEnv = map[string][]string{
+	"font":     {"FIGLET4GO_FONT"},
+	"fontpath": {"FIGLET4GO_FONTPATH", "FIGLET_FONTDIR"},
+	"colors":   {"FIGLET4GO_COLORS"},
+	"parser":   {"FIGLET4GO_PARSER"},
+}
+
+// Settings which take a file or directory, a leading ~ is expanded
+var configPaths = map[string]bool{
+	"fontpath": true,
+}
+
+// Environment variable to use another config file
+const configPathEnv string = "FIGLET4GO_CONFIG"
+
+// A default value with the place it was set
+type setting struct {
+	value  string
+	source string
+}
+
+// Defaults read from the config file and the environment
+// Loaded in run before any command is set up
+var settings = map[string]setting{}
+
+// Get the default value of a setting, fallback if it isn't configured
+func configDefault(key, fallback string) string {
+	if s, ok := settings[key]; ok {
+		return s.value
+	}
+	return fallback
+}
+
+// Path of the config file
+// $FIGLET4GO_CONFIG or figlet4go/config in the XDG config directory
+func configPath() (string, error) {
+	if path := os.Getenv(configPathEnv); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "figlet4go", "config"), nil
+}
+
+// Load the settings from the config file and the environment
+// The environment overrides the config file
+func loadConfig() (map[string]setting, error) {
+	loaded := map[string]setting{}
+
+	path, err := configPath()
+	if err == nil {
+		f, err := os.Open(path)
+		switch {
+		case err == nil:
+			defer f.Close()
+			if err := parseConfig(f, path, loaded); err != nil {
+				return nil, err
+			}
+		// A missing default config file is fine
+		case !os.IsNotExist(err) || os.Getenv(configPathEnv) != "":
+			return nil, err
+		}
+	}
+
+	for _, key := range configKeys {
+		for _, env := range configEnv[key] {
+			if value := os.Getenv(env); value != "" {
+				loaded[key] = setting{configValue(key, value), "env " + env}
+				break
+			}
+		}
+	}
+
+	return loaded, nil
+}
+
+// Parse a config file with "key = value" lines
+// Empty lines and lines starting with # are ignored
+func parseConfig(r io.Reader, path string, loaded map[string]setting) error {
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf("%s:%d: expected key = value", path, lineNum)
+		}
+		key = strings.TrimSpace(key)
+		if _, known := configEnv[key]; !known {
+			return fmt.Errorf("%s:%d: unknown setting %q", path, lineNum, key)
+		}
+
+		loaded[key] = setting{
+			value:  configValue(key, strings.TrimSpace(value)),
+			source: fmt.Sprintf("config %s:%d", path, lineNum),
+		}
+	}
+	return scanner.Err()
+}
+
+// Get the value of a setting, a leading ~ is only expanded for paths
+func configValue(key, value string) string {
+	if configPaths[key] {
+		return expandHome(value)
+	}
+	return value
+}
+
+// Replace a leading ~ with the home directory
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
+
+// Print the effective value of each setting and where it comes from
+func printConfig(w io.Writer, fs *flag.FlagSet) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SETTING\tVALUE\tSOURCE")
+
+	for _, key := range configKeys {
+		f := fs.Lookup(key)
+		if f == nil {
+			continue
+		}
+
+		source := "default"
+		if s, ok := settings[key]; ok {
+			source = s.source
+		}
+		fs.Visit(func(set *flag.Flag) {
+			if set.Name == key {
+				source = "flag"
+			}
+		})
+
+		fmt.Fprintf(tw, "%s\t%q\t%s\n", key, f.Value.String(), source)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseConfig(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip(err)
+	}
+
+	config := "" +
+		"# Comment\n" +
+		"\n" +
+		"font = ~/larry3d\n" +
+		"  fontpath=~/fonts  \n" +
+		"colors = red\n"
+	loaded := map[string]setting{}
+	if err := parseConfig(strings.NewReader(config), "config", loaded); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]setting{
+		// Only paths are expanded
+		"font":     {"~/larry3d", "config config:3"},
+		"fontpath": {filepath.Join(home, "fonts"), "config config:4"},
+		"colors":   {"red", "config config:5"},
+	}
+	if len(loaded) != len(want) {
+		t.Errorf("got %d settings, want %d", len(loaded), len(want))
+	}
+	for key, s := range want {
+		if loaded[key] != s {
+			t.Errorf("%s: got %+v, want %+v", key, loaded[key], s)
+		}
+	}
+}
+
+func TestParseConfigErrors(t *testing.T) {
+	tests := []struct {
+		config string
+		want   string
+	}{
+		{"font larry3d\n", "config:1: expected key = value"},
+		{"# Comment\nsize = 3\n", `config:2: unknown setting "size"`},
+	}
+
+	for _, test := range tests {
+		err := parseConfig(strings.NewReader(test.config), "config", map[string]setting{})
+		if err == nil || err.Error() != test.want {
+			t.Errorf("%q: got error %v, want %q", test.config, err, test.want)
+		}
+	}
+}
+
+func TestLoadConfigEnv(t *testing.T) {
+	t.Setenv(configPathEnv, filepath.Join(t.TempDir(), "config"))
+	if _, err := loadConfig(); err == nil {
+		t.Error("expected an error for a missing config file")
+	}
+
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte("font = larry3d\nparser = html\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(configPathEnv, path)
+	t.Setenv("FIGLET4GO_PARSER", "~/json")
+
+	// The environment overrides the config file
+	loaded, err := loadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded["font"].value != "larry3d" || loaded["parser"] != (setting{"~/json", "env FIGLET4GO_PARSER"}) {
+		t.Errorf("unexpected settings %+v", loaded)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/HoldenLucas/figlet4go/bdf"
+	"io"
+	"os"
+	"unicode/utf8"
+)
+
+// Convert BDF bitmap fonts to FIGfonts
+var convertCommand = &command{
+	name:  "convert",
+	args:  "[flags] <font.bdf>",
+	short: "Convert a BDF bitmap font to a FIGfont (.flf)",
+	setup: func(fs *flag.FlagSet) func(args []string) error {
+		output := fs.String("o", "", "File to write the FIGfont to, stdout if empty")
+		fill := fs.String("fill", "#", "Char used for set pixels")
+		halfBlocks := fs.Bool("halfblocks", false, "Use half block chars for double vertical resolution")
+		comment := fs.String("comment", "", "Comment written to the font header")
+
+		return func(args []string) error {
+			if len(args) != 1 {
+				return usageError{"expected exactly one BDF font"}
+			}
+			if utf8.RuneCountInString(*fill) != 1 {
+				return usageError{"-fill must be a single char"}
+			}
+
+			in, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			font, err := bdf.Parse(in)
+			if err != nil {
+				return err
+			}
+
+			opt := bdf.NewOptions()
+			opt.Fill, _ = utf8.DecodeRuneInString(*fill)
+			opt.HalfBlocks = *halfBlocks
+			opt.Comment = *comment
+
+			var out io.Writer = os.Stdout
+			if *output != "" {
+				f, err := os.Create(*output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			if err := font.WriteFLF(out, opt); err != nil {
+				return err
+			}
+			if *output != "" {
+				fmt.Fprintf(os.Stderr, "Wrote %s\n", *output)
+			}
+			return nil
+		}
+	},
+}
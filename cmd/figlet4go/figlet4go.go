@@ -1,125 +1,187 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
-	"github.com/mbndr/figlet4go"
-	"log"
+	"io"
 	"os"
 	"strings"
 )
 
-var (
-	str      *string = flag.String("str", "", "String to be converted with FIGlet")
-	font     *string = flag.String("font", "", "Font name to use")
-	fontpath *string = flag.String("fontpath", "", "Font path to load fonts from")
-	colors   *string = flag.String("colors", "", "Character colors separated by ';'\n\tPossible colors: black, red, green, yellow, blue, magenta, cyan, white, or any hexcode (f.e. '885DBA')")
-	parser   *string = flag.String("parser", "terminal", "Parser to use\tPossible parsers: terminal, html")
-	file     *string = flag.String("file", "", "File to write to")
+// Exit codes
+const (
+	// Everything went fine
+	exitOK int = 0
+	// The command failed
+	exitError int = 1
+	// The command was called wrong
+	exitUsage int = 2
 )
 
-func main() {
-	// Parse the flags
-	flag.Parse()
+// A (sub)command of the cli
+type command struct {
+	// Name used on the command line
+	name string
+	// Arguments shown in the usage line
+	args string
+	// Short description for the command list
+	short string
+	// Registers the flags of the command and returns the function
+	// running it with the remaining arguments
+	// nil for commands which only group subcommands
+	setup func(fs *flag.FlagSet) func(args []string) error
+	// Subcommands
+	subcommands []*command
+}
 
-	// Validate and log the error
-	validate()
+// Error returned by commands if they were called wrong
+type usageError struct {
+	msg string
+}
 
-	// Create objects
-	ascii := figlet4go.NewAsciiRender()
-	options := figlet4go.NewRenderOptions()
+func (e usageError) Error() string {
+	return e.msg
+}
 
-	// Load fonts
-	if *fontpath != "" {
-		ascii.LoadFont(*fontpath)
+// All top level commands, set in init because
+// the completion command refers to them
+var commands []*command
+
+func init() {
+	commands = []*command{
+		renderCommand,
+		fontsCommand,
+		previewCommand,
+		convertCommand,
+		serveCommand,
+		completionCommand,
 	}
+}
 
-	// Set the font
-	options.FontName = *font
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
 
-	// Set the parser
-	p, err := figlet4go.GetParser(*parser)
+// Run the command given by the arguments and return the exit code
+// Without a known command the arguments are rendered (f.e. figlet4go -str foo)
+func run(args []string) int {
+	// Defaults from the config file and the environment
+	loaded, err := loadConfig()
 	if err != nil {
-		p, _ = figlet4go.GetParser("terminal")
+		fmt.Fprintf(os.Stderr, "figlet4go: %v\n", err)
+		return exitError
 	}
-	options.Parser = *p
+	settings = loaded
 
-	// Set colors
-	if *colors != "" {
-		options.FontColor = getColorSlice(*colors)
+	if len(args) > 0 && isHelp(args[0]) {
+		printCommands(os.Stdout)
+		return exitOK
+	}
+	if len(args) > 0 {
+		if c := findCommand(commands, args[0]); c != nil {
+			return runCommand(c, []string{"figlet4go"}, args[1:])
+		}
 	}
 
-	// Render the string
-	renderStr, err := ascii.RenderOpts(*str, options)
-	if err != nil {
-		log.Fatal(err)
+	return runCommand(renderCommand, []string{"figlet4go"}, args)
+}
+
+// Run a command or dispatch to its subcommands
+func runCommand(c *command, parents []string, args []string) int {
+	path := append(append([]string{}, parents...), c.name)
+
+	// Group of subcommands
+	if c.setup == nil {
+		if len(args) > 0 && isHelp(args[0]) {
+			printUsage(os.Stdout, c, path, nil)
+			return exitOK
+		}
+		if len(args) == 0 {
+			printUsage(os.Stderr, c, path, nil)
+			return exitUsage
+		}
+		sub := findCommand(c.subcommands, args[0])
+		if sub == nil {
+			fmt.Fprintf(os.Stderr, "%s: unknown command %q\n", strings.Join(path, " "), args[0])
+			printUsage(os.Stderr, c, path, nil)
+			return exitUsage
+		}
+		return runCommand(sub, path, args[1:])
+	}
+
+	fs := flag.NewFlagSet(strings.Join(path, " "), flag.ContinueOnError)
+	runFunc := c.setup(fs)
+	fs.Usage = func() {
+		printUsage(fs.Output(), c, path, fs)
 	}
 
-	// Write to file if given
-	if *file != "" {
-		// Create file
-		f, err := os.Create(*file)
-		defer f.Close()
-		if err != nil {
-			log.Fatal(err)
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
 		}
-		// Write to file
-		b, err := f.WriteString(renderStr)
-		if err != nil {
-			log.Fatal(err)
+		return exitUsage
+	}
+
+	if err := runFunc(fs.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", strings.Join(path, " "), err)
+		if errors.As(err, &usageError{}) {
+			fs.Usage()
+			return exitUsage
 		}
-		fmt.Printf("Wrote %d bytes to %s\n", b, *file)
-		return
+		return exitError
 	}
 
-	// Default is printing
-	fmt.Print(renderStr)
+	return exitOK
 }
 
-// Get a slice with colors to give to the RenderOptions
-// Splits the given string with the separator ";"
-func getColorSlice(colorStr string) []figlet4go.Color {
-
-	givenColors := strings.Split(colorStr, ";")
-
-	colors := make([]figlet4go.Color, len(givenColors))
-
-	for i, c := range givenColors {
-		switch c {
-		case "black":
-			colors[i] = figlet4go.ColorBlack
-		case "red":
-			colors[i] = figlet4go.ColorRed
-		case "green":
-			colors[i] = figlet4go.ColorGreen
-		case "yellow":
-			colors[i] = figlet4go.ColorYellow
-		case "blue":
-			colors[i] = figlet4go.ColorBlue
-		case "magenta":
-			colors[i] = figlet4go.ColorMagenta
-		case "cyan":
-			colors[i] = figlet4go.ColorCyan
-		case "white":
-			colors[i] = figlet4go.ColorWhite
-		default:
-			// Try to parse the TrueColor from the string
-			color, err := figlet4go.NewTrueColorFromHexString(c)
-			if err != nil {
-				log.Fatal(err)
-			}
-			colors[i] = color
+// Get a command by its name
+func findCommand(cmds []*command, name string) *command {
+	for _, c := range cmds {
+		if c.name == name {
+			return c
 		}
 	}
+	return nil
+}
 
-	return colors
+// Whether the argument asks for help
+func isHelp(arg string) bool {
+	switch arg {
+	case "help", "-h", "-help", "--help":
+		return true
+	}
+	return false
 }
 
-// Validate if all required options are given
-// flag.Parse() must be called before this
-func validate() {
-	if *str == "" {
-		flag.Usage()
-		os.Exit(1)
+// Print the usage of a command with its flags or subcommands
+func printUsage(w io.Writer, c *command, path []string, fs *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: %s %s\n\n%s\n", strings.Join(path, " "), c.args, c.short)
+
+	if len(c.subcommands) > 0 {
+		fmt.Fprintln(w, "\nCommands:")
+		for _, sub := range c.subcommands {
+			fmt.Fprintf(w, "  %-12s %s\n", sub.name, sub.short)
+		}
+	}
+
+	if fs != nil {
+		fmt.Fprintln(w, "\nFlags:")
+		fs.SetOutput(w)
+		fs.PrintDefaults()
+	}
+}
+
+// Print the top level usage with all commands
+func printCommands(w io.Writer) {
+	fmt.Fprintln(w, "Usage: figlet4go <command> [flags] [args]")
+	fmt.Fprintln(w, "\nWithout a command the arguments are passed to render.")
+	fmt.Fprintln(w, "\nCommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-12s %s\n", c.name, c.short)
+		for _, sub := range c.subcommands {
+			fmt.Fprintf(w, "  %-12s %s\n", c.name+" "+sub.name, sub.short)
+		}
 	}
+	fmt.Fprintln(w, "\nRun 'figlet4go <command> -h' for help on a command.")
 }
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/HoldenLucas/figlet4go"
+)
+
+// Inspect the available fonts
+var fontsCommand = &command{
+	name:  "fonts",
+	args:  "<command> [flags]",
+	short: "List and inspect the available fonts",
+	subcommands: []*command{
+		fontsListCommand,
+		fontsInfoCommand,
+		fontsLintCommand,
+	},
+}
+
+// List all fonts (like figlet -l)
+var fontsListCommand = &command{
+	name:  "list",
+	args:  "[flags]",
+	short: "List the available fonts",
+	setup: func(fs *flag.FlagSet) func(args []string) error {
+		names := fs.Bool("names", false, "Only print the font names")
+		ff := addFontFlags(fs)
+
+		return func(args []string) error {
+			if len(args) > 0 {
+				return usageError{"unexpected arguments"}
+			}
+			ascii, err := ff.renderer()
+			if err != nil {
+				return err
+			}
+
+			if *names {
+				for _, f := range ascii.Fonts() {
+					fmt.Println(f.Name)
+				}
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tSOURCE\tLOADED\tPATH")
+			for _, f := range ascii.Fonts() {
+				fmt.Fprintf(w, "%s\t%s\t%t\t%s\n", f.Name, f.Source, f.Loaded, f.Path)
+			}
+			return w.Flush()
+		}
+	},
+}
+
+// Show the details of a single font
+var fontsInfoCommand = &command{
+	name:  "info",
+	args:  "[flags] <name>",
+	short: "Show information about a font",
+	setup: func(fs *flag.FlagSet) func(args []string) error {
+		ff := addFontFlags(fs)
+
+		return func(args []string) error {
+			if len(args) != 1 {
+				return usageError{"expected exactly one font name"}
+			}
+			ascii, err := ff.renderer()
+			if err != nil {
+				return err
+			}
+
+			info, err := ascii.FontInfo(args[0])
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "Name:\t%s\n", info.Name)
+			fmt.Fprintf(w, "Source:\t%s\n", info.Source)
+			fmt.Fprintf(w, "Path:\t%s\n", info.Path)
+			fmt.Fprintf(w, "Height:\t%d\n", info.Height)
+			return w.Flush()
+		}
+	},
+}
+
+// Check font files for problems
+var fontsLintCommand = &command{
+	name:  "lint",
+	args:  "<file or directory>...",
+	short: "Check font files for problems",
+	setup: func(fs *flag.FlagSet) func(args []string) error {
+		return func(args []string) error {
+			if len(args) == 0 {
+				return usageError{"expected at least one font file or directory"}
+			}
+
+			files, err := lintFiles(args)
+			if err != nil {
+				return err
+			}
+
+			broken := 0
+			for _, file := range files {
+				diags, err := lintFile(file)
+				if err != nil {
+					return err
+				}
+				for _, d := range diags {
+					if d.Line == 0 {
+						fmt.Printf("%s: %s\n", file, d.Message)
+					} else {
+						fmt.Printf("%s:%d: %s\n", file, d.Line, d.Message)
+					}
+				}
+				if len(diags) > 0 {
+					broken++
+				}
+			}
+
+			if broken > 0 {
+				return fmt.Errorf("%d of %d fonts have problems", broken, len(files))
+			}
+			return nil
+		}
+	},
+}
+
+// Get the files to lint, directories are searched for fonts
+func lintFiles(args []string) ([]string, error) {
+	files := []string{}
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+
+		err = filepath.WalkDir(arg, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if ext := filepath.Ext(path); !d.IsDir() && (ext == ".flf" || ext == ".tlf") {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// Validate a single font file
+func lintFile(path string) ([]figlet4go.Diagnostic, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return figlet4go.Validate(f)
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"flag"
+	"github.com/HoldenLucas/figlet4go"
+	"strings"
+)
+
+// Flags to load, choose and style the font, shared by several commands
+type fontFlags struct {
+	font     *string
+	fontpath *string
+	colors   *string
+	parser   *string
+	width    *int
+	layout   *string
+	controls []string
+}
+
+// Layouts by their name in -layout
+var layouts = map[string]figlet4go.Layout{
+	"full":        figlet4go.LayoutFullWidth,
+	"font":        figlet4go.LayoutFont,
+	"kerning":     figlet4go.LayoutKerning,
+	"smushing":    figlet4go.LayoutSmushing,
+	"overlapping": figlet4go.LayoutOverlapping,
+}
+
+// Register the flag to load fonts
+func addFontFlags(fs *flag.FlagSet) *fontFlags {
+	font := configDefault("font", "")
+	colors := configDefault("colors", "")
+	parser := configDefault("parser", "terminal")
+	return &fontFlags{
+		font:     &font,
+		fontpath: fs.String("fontpath", configDefault("fontpath", ""), "Font path to load fonts from"),
+		colors:   &colors,
+		parser:   &parser,
+		width:    new(int),
+		layout:   new(string),
+	}
+}
+
+// Register the flags used for rendering
+// -font is only added if withFont is set
+func (ff *fontFlags) addRenderFlags(fs *flag.FlagSet, withFont bool) {
+	if withFont {
+		ff.font = fs.String("font", *ff.font, "Font name to use")
+	}
+	ff.colors = fs.String("colors", *ff.colors, "Character colors separated by ';'\n\tPossible colors: black, red, green, yellow, blue, magenta, cyan, white, or any hexcode (f.e. '885DBA')")
+	ff.parser = fs.String("parser", *ff.parser, "Parser to use\n\tPossible parsers: terminal, html, html-pre, markdown, markdown-html, json, json-runs, irc, chat, svg")
+	ff.width = fs.Int("width", 0, "Maximum output width in columns, longer text is wrapped (0 for no limit)")
+	ff.layout = fs.String("layout", "full", "How the chars are fitted together\n\tPossible layouts: full, font (the layout of the font, like figlet), kerning, smushing, overlapping")
+	fs.Func("control", "Control file (.flc) translating the input, may be repeated", func(path string) error {
+		ff.controls = append(ff.controls, path)
+		return nil
+	})
+}
+
+// Create a renderer with the fonts of -fontpath loaded
+func (ff *fontFlags) renderer() (*figlet4go.AsciiRender, error) {
+	ascii := figlet4go.NewAsciiRender()
+
+	if *ff.fontpath != "" {
+		if err := ascii.LoadFont(*ff.fontpath); err != nil {
+			return nil, err
+		}
+	}
+
+	return ascii, nil
+}
+
+// Create the RenderOptions from the flags
+func (ff *fontFlags) options() (*figlet4go.RenderOptions, error) {
+	options := figlet4go.NewRenderOptions()
+
+	// Set the font, unknown fonts are an error
+	if *ff.font != "" {
+		options.FontName = *ff.font
+	}
+
+	// Set the parser
+	p, err := figlet4go.GetParser(*ff.parser)
+	if err != nil {
+		return nil, usageError{err.Error()}
+	}
+	options.Parser = *p
+
+	// Set colors
+	if *ff.colors != "" {
+		colors, err := getColorSlice(*ff.colors)
+		if err != nil {
+			return nil, usageError{err.Error()}
+		}
+		options.FontColor = colors
+	}
+
+	if *ff.width < 0 {
+		return nil, usageError{"width must not be negative"}
+	}
+	options.Width = *ff.width
+
+	if *ff.layout != "" {
+		layout, ok := layouts[*ff.layout]
+		if !ok {
+			return nil, usageError{"invalid layout: " + *ff.layout}
+		}
+		options.Layout = layout
+	}
+
+	// Load the control files
+	for _, path := range ff.controls {
+		control, err := figlet4go.LoadControl(path)
+		if err != nil {
+			return nil, err
+		}
+		options.Controls = append(options.Controls, control)
+	}
+
+	return options, nil
+}
+
+// Get a slice with colors to give to the RenderOptions
+// Splits the given string with the separator ";"
+func getColorSlice(colorStr string) ([]figlet4go.Color, error) {
+
+	givenColors := strings.Split(colorStr, ";")
+
+	colors := make([]figlet4go.Color, len(givenColors))
+
+	for i, c := range givenColors {
+		switch c {
+		case "black":
+			colors[i] = figlet4go.ColorBlack
+		case "red":
+			colors[i] = figlet4go.ColorRed
+		case "green":
+			colors[i] = figlet4go.ColorGreen
+		case "yellow":
+			colors[i] = figlet4go.ColorYellow
+		case "blue":
+			colors[i] = figlet4go.ColorBlue
+		case "magenta":
+			colors[i] = figlet4go.ColorMagenta
+		case "cyan":
+			colors[i] = figlet4go.ColorCyan
+		case "white":
+			colors[i] = figlet4go.ColorWhite
+		default:
+			// Try to parse the TrueColor from the string
+			color, err := figlet4go.NewTrueColorFromHexString(c)
+			if err != nil {
+				return nil, err
+			}
+			colors[i] = color
+		}
+	}
+
+	return colors, nil
+}
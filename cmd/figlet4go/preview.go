@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Render a sample in every font (like showfigfonts)
+var previewCommand = &command{
+	name:  "preview",
+	args:  "[flags] [text...]",
+	short: "Render a sample (default: the font name) in every available font",
+	setup: func(fs *flag.FlagSet) func(args []string) error {
+		ff := addFontFlags(fs)
+		ff.addRenderFlags(fs, false)
+
+		return func(args []string) error {
+			ascii, err := ff.renderer()
+			if err != nil {
+				return err
+			}
+			options, err := ff.options()
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, p := range ascii.Preview(strings.Join(args, " "), options) {
+				// Report failing fonts but go on with the others
+				if p.Err != nil {
+					fmt.Fprintf(os.Stderr, "%s: %v\n", p.Font.Name, p.Err)
+					failed++
+					continue
+				}
+				fmt.Printf("%s (height %d):\n%s\n", p.Font.Name, p.Height, p.Output)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d fonts failed", failed)
+			}
+			return nil
+		}
+	},
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Render text as banners
+var renderCommand = &command{
+	name:  "render",
+	args:  "[flags] [text...]",
+	short: "Render text as a FIGlet banner (default command)",
+	setup: func(fs *flag.FlagSet) func(args []string) error {
+		str := fs.String("str", "", "String to be converted with FIGlet\n\tIf empty the arguments are used, without arguments the lines of -input are rendered")
+		input := fs.String("input", "-", "File to read the strings from, '-' for stdin")
+		paragraph := fs.Bool("paragraph", false, "Join consecutive input lines, render each paragraph as one banner")
+		file := fs.String("file", "", "File to write to")
+		printCfg := fs.Bool("print-config", false, "Print the effective settings and where they come from")
+		ff := addFontFlags(fs)
+		ff.addRenderFlags(fs, true)
+
+		return func(args []string) error {
+			if *printCfg {
+				return printConfig(os.Stdout, fs)
+			}
+
+			ascii, err := ff.renderer()
+			if err != nil {
+				return err
+			}
+			options, err := ff.options()
+			if err != nil {
+				return err
+			}
+
+			// Open the input before the output, a missing input
+			// doesn't create the file
+			text := argText(*str, args)
+			var in io.ReadCloser
+			if text == "" {
+				if in, err = openInput(*input); err != nil {
+					return err
+				}
+				defer in.Close()
+			}
+
+			// Write to file if given, default is printing
+			var out io.Writer = os.Stdout
+			var f *os.File
+			if *file != "" {
+				if f, err = os.Create(*file); err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			// Render each string as a separate banner, which is
+			// written before the next one is read
+			written := 0
+			render := func(text string) error {
+				banner, err := ascii.RenderOpts(text, options)
+				if err != nil {
+					return err
+				}
+				n, err := io.WriteString(out, banner)
+				written += n
+				return err
+			}
+			if in != nil {
+				err = readTexts(in, *paragraph, render)
+			} else {
+				err = render(text)
+			}
+			if err != nil {
+				return err
+			}
+
+			if f != nil {
+				if err := f.Close(); err != nil {
+					return err
+				}
+				fmt.Printf("Wrote %d bytes to %s\n", written, *file)
+			}
+			return nil
+		}
+	},
+}
+
+// Get the string to render given by -str or the arguments
+// joined by spaces, empty if the strings are read from the input
+func argText(str string, args []string) string {
+	if str != "" {
+		return str
+	}
+	return strings.Join(args, " ")
+}
+
+// Open the file to read the strings from, '-' for stdin
+// Without a string to render stdin must not be a terminal
+func openInput(input string) (io.ReadCloser, error) {
+	if input != "-" {
+		return os.Open(input)
+	}
+	if isTerminal(os.Stdin) {
+		return nil, usageError{"no text given and stdin is a terminal"}
+	}
+	return io.NopCloser(os.Stdin), nil
+}
+
+// Check if a file is a terminal, any char device except the null device
+func isTerminal(f *os.File) bool {
+	stat, err := f.Stat()
+	if err != nil || stat.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	null, err := os.Stat(os.DevNull)
+	return err != nil || !os.SameFile(stat, null)
+}
+
+// Read the lines of r, each line is a string passed to render
+// as soon as it is read, like figlet does
+// In paragraph mode consecutive lines are joined by a space,
+// paragraphs are separated by blank lines
+func readTexts(r io.Reader, paragraph bool, render func(string) error) error {
+	lines := []string{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		if !paragraph {
+			if err := render(line); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// A blank line ends the paragraph
+		if strings.TrimSpace(line) == "" {
+			if len(lines) > 0 {
+				if err := render(strings.Join(lines, " ")); err != nil {
+					return err
+				}
+				lines = lines[:0]
+			}
+			continue
+		}
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(lines) > 0 {
+		return render(strings.Join(lines, " "))
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadTexts(t *testing.T) {
+	input := "first line\r\n" +
+		"  second\n" +
+		"\n" +
+		"third\n" +
+		"\t\n" +
+		"\n" +
+		"last"
+
+	tests := []struct {
+		paragraph bool
+		want      []string
+	}{
+		{false, []string{"first line", "  second", "", "third", "\t", "", "last"}},
+		{true, []string{"first line second", "third", "last"}},
+	}
+
+	for _, test := range tests {
+		texts := []string{}
+		err := readTexts(strings.NewReader(input), test.paragraph, func(text string) error {
+			texts = append(texts, text)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(texts, test.want) {
+			t.Errorf("paragraph %t: got %q, want %q", test.paragraph, texts, test.want)
+		}
+	}
+}
+
+func TestReadTextsError(t *testing.T) {
+	// Reading stops at the first error
+	stop := errors.New("stop")
+	calls := 0
+	err := readTexts(strings.NewReader("a\n\nb\n"), true, func(text string) error {
+		calls++
+		return stop
+	})
+	if err != stop || calls != 1 {
+		t.Errorf("got error %v after %d calls", err, calls)
+	}
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"image/png"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/HoldenLucas/figlet4go"
+)
+
+// Serve rendering over HTTP
+var serveCommand = &command{
+	name:  "serve",
+	args:  "[flags]",
+	short: "Serve rendering over HTTP as text, HTML, SVG or PNG",
+	setup: func(fs *flag.FlagSet) func(args []string) error {
+		addr := fs.String("addr", "localhost:8080", "Address to listen on")
+		maxBody := fs.Int64("max-body", 64<<10, "Maximum size of a request body in bytes")
+		maxText := fs.Int("max-text", 1000, "Maximum length of the text in chars")
+		maxWidth := fs.Int("max-width", 1000, "Maximum output width in columns")
+		timeout := fs.Duration("timeout", 10*time.Second, "Timeout for reading, rendering and writing a request")
+		cacheEntries := fs.Int("cache-entries", 1000, "Maximum number of cached results (0 for no limit)")
+		cacheBytes := fs.Int("cache-bytes", 16<<20, "Maximum size of the cached results in bytes (0 for no limit)\n\tThe cache is disabled if both limits are 0")
+		ff := addFontFlags(fs)
+		ff.addRenderFlags(fs, true)
+
+		return func(args []string) error {
+			if len(args) > 0 {
+				return usageError{"unexpected arguments"}
+			}
+			ascii, err := ff.renderer()
+			if err != nil {
+				return err
+			}
+			// Frequent texts (f.e. titles) are only rendered once
+			ascii.EnableCache(*cacheEntries, *cacheBytes)
+
+			// The flags are the defaults of every request
+			defaults, err := ff.options()
+			if err != nil {
+				return err
+			}
+
+			s := &server{
+				ascii:    ascii,
+				defaults: defaults,
+				maxBody:  *maxBody,
+				maxText:  *maxText,
+				maxWidth: *maxWidth,
+			}
+			srv := &http.Server{
+				Addr:              *addr,
+				Handler:           http.TimeoutHandler(s, *timeout, "rendering timed out\n"),
+				ReadHeaderTimeout: *timeout,
+				ReadTimeout:       *timeout,
+				// The timeout handler has to be able to write its response
+				WriteTimeout:   *timeout + time.Second,
+				IdleTimeout:    time.Minute,
+				MaxHeaderBytes: 16 << 10,
+			}
+
+			// Shut down gracefully on interrupt
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), *timeout)
+				defer cancel()
+				srv.Shutdown(shutdownCtx)
+			}()
+
+			log.Printf("listening on http://%s", *addr)
+			if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+
+			stats := ascii.CacheStats()
+			log.Printf("cache: %d hits, %d misses, %d evictions", stats.Hits, stats.Misses, stats.Evictions)
+			return nil
+		}
+	},
+}
+
+// Content types the server can respond with
+const (
+	contentText string = "text/plain"
+	contentHTML string = "text/html"
+	contentSVG  string = "image/svg+xml"
+	contentPNG  string = "image/png"
+	// Only used for explicit parsers
+	contentMarkdown string = "text/markdown"
+	contentJSON     string = "application/json"
+)
+
+// Content types of the parsers
+var parserContentTypes = map[string]string{
+	"terminal": contentText,
+	"html":     contentHTML,
+	"html-pre": contentHTML,
+	"svg":      contentSVG,
+	// Inline HTML is part of Markdown
+	"markdown":      contentMarkdown,
+	"markdown-html": contentMarkdown,
+	"json":          contentJSON,
+	"json-runs":     contentJSON,
+	"irc":           contentText,
+	"chat":          contentText,
+}
+
+// Parsers for the content types, PNG doesn't use a parser
+var contentParsers = map[string]string{
+	contentText: "terminal",
+	contentHTML: "html",
+	contentSVG:  "svg",
+}
+
+// HTTP handler rendering the requested text with a shared renderer
+type server struct {
+	ascii    *figlet4go.AsciiRender
+	defaults *figlet4go.RenderOptions
+	maxBody  int64
+	maxText  int
+	maxWidth int
+}
+
+// Parameters of a render request, from the query or a JSON body
+type renderRequest struct {
+	Text   string `json:"text"`
+	Font   string `json:"font"`
+	Colors string `json:"colors"`
+	Parser string `json:"parser"`
+	Width  int    `json:"width"`
+}
+
+// Error with the HTTP status to respond with
+type httpError struct {
+	status int
+	msg    string
+}
+
+func (e httpError) Error() string {
+	return e.msg
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := s.serve(w, r); err != nil {
+		status := http.StatusInternalServerError
+		var he httpError
+		var nf *figlet4go.ErrFontNotFound
+		var nc *figlet4go.ErrCharNotInFont
+		switch {
+		case errors.As(err, &he):
+			status = he.status
+		case errors.As(err, &nf):
+			status = http.StatusNotFound
+		case errors.As(err, &nc):
+			// The text can't be rendered in the font
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+	}
+}
+
+// Render a request and write the response
+func (s *server) serve(w http.ResponseWriter, r *http.Request) error {
+	req, err := s.parseRequest(w, r)
+	if err != nil {
+		return err
+	}
+	if req.Text == "" {
+		return httpError{http.StatusBadRequest, "missing text"}
+	}
+	if utf8.RuneCountInString(req.Text) > s.maxText {
+		return httpError{http.StatusRequestEntityTooLarge, "text longer than " + strconv.Itoa(s.maxText) + " chars"}
+	}
+
+	contentType := negotiate(r.Header.Get("Accept"))
+	if req.Parser != "" {
+		// An explicit parser decides the content type
+		ct, ok := parserContentTypes[req.Parser]
+		if !ok {
+			return httpError{http.StatusBadRequest, "invalid parser: " + req.Parser}
+		}
+		contentType = ct
+	}
+	if contentType == "" {
+		return httpError{http.StatusNotAcceptable, "acceptable types: " + strings.Join([]string{contentText, contentHTML, contentSVG, contentPNG}, ", ")}
+	}
+
+	opt, err := s.options(req, contentType)
+	if err != nil {
+		return err
+	}
+
+	// Render completely before writing, errors can still change the status
+	var out bytes.Buffer
+	switch contentType {
+	case contentPNG:
+		img, err := s.ascii.RenderImage(req.Text, opt)
+		if err != nil {
+			return err
+		}
+		if err := png.Encode(&out, img); err != nil {
+			return err
+		}
+	case contentSVG:
+		svg, err := s.ascii.RenderSVG(req.Text, opt)
+		if err != nil {
+			return err
+		}
+		out.WriteString(svg)
+	default:
+		str, err := s.ascii.RenderOpts(req.Text, opt)
+		if err != nil {
+			return err
+		}
+		out.WriteString(str)
+	}
+
+	if contentType != contentPNG {
+		contentType += "; charset=utf-8"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Vary", "Accept")
+	_, err = out.WriteTo(w)
+	return err
+}
+
+// Read the parameters from the query (GET) or a JSON body (POST)
+func (s *server) parseRequest(w http.ResponseWriter, r *http.Request) (renderRequest, error) {
+	var req renderRequest
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		q := r.URL.Query()
+		req.Text = q.Get("text")
+		req.Font = q.Get("font")
+		req.Colors = q.Get("colors")
+		req.Parser = q.Get("parser")
+		if width := q.Get("width"); width != "" {
+			var err error
+			if req.Width, err = strconv.Atoi(width); err != nil {
+				return req, httpError{http.StatusBadRequest, "invalid width: " + width}
+			}
+		}
+
+	case http.MethodPost:
+		if ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); ct != "application/json" {
+			return req, httpError{http.StatusUnsupportedMediaType, "expected an application/json body"}
+		}
+		dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.maxBody))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&req); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return req, httpError{http.StatusRequestEntityTooLarge, "body larger than " + strconv.FormatInt(s.maxBody, 10) + " bytes"}
+			}
+			return req, httpError{http.StatusBadRequest, "invalid JSON body: " + err.Error()}
+		}
+
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		return req, httpError{http.StatusMethodNotAllowed, "method not allowed"}
+	}
+
+	if req.Width < 0 || req.Width > s.maxWidth {
+		return req, httpError{http.StatusBadRequest, "width must be between 0 and " + strconv.Itoa(s.maxWidth)}
+	}
+	return req, nil
+}
+
+// Create the RenderOptions of a request based on the defaults
+func (s *server) options(req renderRequest, contentType string) (*figlet4go.RenderOptions, error) {
+	opt := *s.defaults
+
+	if req.Font != "" {
+		opt.FontName = req.Font
+	}
+	if req.Colors != "" {
+		colors, err := getColorSlice(req.Colors)
+		if err != nil {
+			return nil, httpError{http.StatusBadRequest, err.Error()}
+		}
+		opt.FontColor = colors
+	}
+	name, ok := contentParsers[contentType]
+	if req.Parser != "" {
+		name, ok = req.Parser, true
+	}
+	if ok {
+		p, err := figlet4go.GetParser(name)
+		if err != nil {
+			return nil, err
+		}
+		opt.Parser = *p
+	}
+	if req.Width > 0 {
+		opt.Width = req.Width
+	}
+	// The output is wrapped even without a width,
+	// long texts in wide fonts would create huge images
+	if opt.Width == 0 || opt.Width > s.maxWidth {
+		opt.Width = s.maxWidth
+	}
+
+	return &opt, nil
+}
+
+// Choose the content type for an Accept header
+// Returns text/plain if the header is empty and "" if no type is acceptable
+func negotiate(accept string) string {
+	if accept == "" {
+		return contentText
+	}
+
+	// Media ranges with their quality
+	type mediaRange struct {
+		typ string
+		q   float64
+	}
+	ranges := []mediaRange{}
+	for _, part := range strings.Split(accept, ",") {
+		typ, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if qStr, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(qStr, 64); err != nil {
+				continue
+			}
+		}
+		if q > 0 {
+			ranges = append(ranges, mediaRange{typ, q})
+		}
+	}
+	// Keep the order of the header for the same quality
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].q > ranges[j].q
+	})
+
+	for _, mr := range ranges {
+		switch mr.typ {
+		case contentText, contentHTML, contentSVG, contentPNG:
+			return mr.typ
+		case "text/*", "*/*":
+			return contentText
+		case "image/*":
+			return contentPNG
+		}
+	}
+	return ""
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/HoldenLucas/figlet4go"
+)
+
+// Server with small limits and the default options
+func newTestServer() *server {
+	return &server{
+		ascii:    figlet4go.NewAsciiRender(),
+		defaults: figlet4go.NewRenderOptions(),
+		maxBody:  100,
+		maxText:  20,
+		maxWidth: 80,
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"", contentText},
+		{"text/html", contentHTML},
+		{"text/html;q=0.5, image/svg+xml", contentSVG},
+		{"application/xml, image/*", contentPNG},
+		{"*/*", contentText},
+		{"text/html;q=0", ""},
+		{"application/xml", ""},
+	}
+
+	for _, test := range tests {
+		if got := negotiate(test.accept); got != test.want {
+			t.Errorf("%q: got %q, want %q", test.accept, got, test.want)
+		}
+	}
+}
+
+func TestServe(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		accept      string
+		body        string
+		status      int
+		wantType    string
+	}{
+		{"text", "GET", "/?text=Hi", "", "", "", http.StatusOK, "text/plain; charset=utf-8"},
+		{"html", "GET", "/?text=Hi", "", "text/html", "", http.StatusOK, "text/html; charset=utf-8"},
+		{"svg", "GET", "/?text=Hi", "", "image/svg+xml", "", http.StatusOK, "image/svg+xml; charset=utf-8"},
+		{"explicit parser", "GET", "/?text=Hi&parser=json", "", "text/html", "", http.StatusOK, "application/json; charset=utf-8"},
+		{"json body", "POST", "/", "application/json", "", `{"text":"Hi","colors":"red"}`, http.StatusOK, "text/plain; charset=utf-8"},
+		{"missing text", "GET", "/", "", "", "", http.StatusBadRequest, ""},
+		{"text too long", "GET", "/?text=" + strings.Repeat("a", 21), "", "", "", http.StatusRequestEntityTooLarge, ""},
+		{"char not in font", "GET", "/?text=a%0Ab", "", "", "", http.StatusBadRequest, ""},
+		{"unknown font", "GET", "/?text=Hi&font=nope", "", "", "", http.StatusNotFound, ""},
+		{"invalid parser", "GET", "/?text=Hi&parser=nope", "", "", "", http.StatusBadRequest, ""},
+		{"invalid colors", "GET", "/?text=Hi&colors=nope", "", "", "", http.StatusBadRequest, ""},
+		{"not acceptable", "GET", "/?text=Hi", "", "application/xml", "", http.StatusNotAcceptable, ""},
+		{"invalid width", "GET", "/?text=Hi&width=wide", "", "", "", http.StatusBadRequest, ""},
+		{"negative width", "GET", "/?text=Hi&width=-1", "", "", "", http.StatusBadRequest, ""},
+		{"width too large", "GET", "/?text=Hi&width=81", "", "", "", http.StatusBadRequest, ""},
+		{"body not json", "POST", "/", "text/plain", "", "Hi", http.StatusUnsupportedMediaType, ""},
+		{"invalid body", "POST", "/", "application/json", "", `{"text":"Hi","size":3}`, http.StatusBadRequest, ""},
+		{"body too large", "POST", "/", "application/json", "", `{"text":"` + strings.Repeat("a", 100) + `"}`, http.StatusRequestEntityTooLarge, ""},
+		{"method", "PUT", "/?text=Hi", "", "", "", http.StatusMethodNotAllowed, ""},
+	}
+
+	s := newTestServer()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(test.method, test.target, strings.NewReader(test.body))
+			if test.contentType != "" {
+				r.Header.Set("Content-Type", test.contentType)
+			}
+			if test.accept != "" {
+				r.Header.Set("Accept", test.accept)
+			}
+			w := httptest.NewRecorder()
+			s.ServeHTTP(w, r)
+
+			if w.Code != test.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, test.status, w.Body)
+			}
+			if test.wantType != "" && w.Header().Get("Content-Type") != test.wantType {
+				t.Errorf("got content type %q, want %q", w.Header().Get("Content-Type"), test.wantType)
+			}
+			if test.status == http.StatusMethodNotAllowed && w.Header().Get("Allow") == "" {
+				t.Error("missing Allow header")
+			}
+		})
+	}
+}
+
+func TestServeText(t *testing.T) {
+	w := httptest.NewRecorder()
+	newTestServer().ServeHTTP(w, httptest.NewRequest("GET", "/?text=Hi", nil))
+
+	want, _ := figlet4go.NewAsciiRender().Render("Hi")
+	if w.Body.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", w.Body, want)
+	}
+}
+
+func TestServePNG(t *testing.T) {
+	r := httptest.NewRequest("GET", "/?text=Hi", nil)
+	r.Header.Set("Accept", "image/png")
+	w := httptest.NewRecorder()
+	newTestServer().ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != contentPNG {
+		t.Fatalf("unexpected response %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	if _, err := png.Decode(w.Body); err != nil {
+		t.Errorf("invalid png: %v", err)
+	}
+}
+
+func TestServeOptions(t *testing.T) {
+	s := newTestServer()
+
+	// The content type chooses the parser, the width is limited
+	opt, err := s.options(renderRequest{Text: "Hi"}, contentHTML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opt.Parser.Name != "html" || opt.Width != s.maxWidth {
+		t.Errorf("unexpected parser %q and width %d", opt.Parser.Name, opt.Width)
+	}
+
+	// An explicit parser wins, the defaults aren't changed
+	opt, err = s.options(renderRequest{Text: "Hi", Font: "larry3d", Parser: "markdown", Colors: "red;00ff00", Width: 40}, contentText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opt.Parser.Name != "markdown" || opt.FontName != "larry3d" || len(opt.FontColor) != 2 || opt.Width != 40 {
+		t.Errorf("unexpected options %+v", opt)
+	}
+	if s.defaults.FontName == "larry3d" || s.defaults.Width != 0 {
+		t.Error("the defaults were changed")
+	}
+
+	// Defaults wider than the limit are limited too
+	s.defaults.Width = 200
+	if opt, _ := s.options(renderRequest{Text: "Hi"}, contentText); opt.Width != s.maxWidth {
+		t.Errorf("got width %d, want %d", opt.Width, s.maxWidth)
+	}
+}
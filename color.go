@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 )
 
 // Escape char
@@ -62,6 +63,9 @@ func (tc TrueColor) getPrefix(p Parser) string {
 
 	case "html":
 		return fmt.Sprintf("<span style='color: rgb(%d,%d,%d);'>", tc.r, tc.g, tc.b)
+
+	case "svg":
+		return fmt.Sprintf("<tspan fill='rgb(%d,%d,%d)'>", tc.r, tc.g, tc.b)
 	}
 
 	return ""
@@ -76,6 +80,9 @@ func (tc TrueColor) getSuffix(p Parser) string {
 
 	case "html":
 		return "</span>"
+
+	case "svg":
+		return "</tspan>"
 	}
 
 	return ""
@@ -84,7 +91,7 @@ func (tc TrueColor) getSuffix(p Parser) string {
 // NewTrueColorFromHexString returns a Truecolor object based on a hexadezimal string
 func NewTrueColorFromHexString(c string) (*TrueColor, error) {
 	rgb, err := hex.DecodeString(c)
-	if err != nil {
+	if err != nil || len(rgb) != 3 {
 		return nil, errors.New("Invalid color given (" + c + ")")
 	}
 
@@ -95,6 +102,55 @@ func NewTrueColorFromHexString(c string) (*TrueColor, error) {
 	}, nil
 }
 
+// Get the index of the color in the palette which is closest to c
+// Compares the distance in the CIELAB color space, which is closer to
+// the perceived difference than the rgb values (f.e. the TrueColor of
+// ColorRed would be closer to orange than to red)
+func nearestColor(c TrueColor, palette []TrueColor) int {
+	l, a, b := c.lab()
+	nearest, nearestDist := 0, math.Inf(1)
+	for i, pc := range palette {
+		pl, pa, pb := pc.lab()
+		dist := (l-pl)*(l-pl) + (a-pa)*(a-pa) + (b-pb)*(b-pb)
+		if dist < nearestDist {
+			nearest, nearestDist = i, dist
+		}
+	}
+	return nearest
+}
+
+// Convert the sRGB color to CIELAB with the D65 white point
+func (tc TrueColor) lab() (l, a, b float64) {
+	linear := func(v int) float64 {
+		c := float64(v) / 255
+		if c <= 0.04045 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+	r, g, bl := linear(tc.r), linear(tc.g), linear(tc.b)
+
+	// XYZ relative to the white point
+	x := (0.4124*r + 0.3576*g + 0.1805*bl) / 0.95047
+	y := 0.2126*r + 0.7152*g + 0.0722*bl
+	z := (0.0193*r + 0.1192*g + 0.9505*bl) / 1.08883
+
+	f := func(t float64) float64 {
+		if t > 216.0/24389 {
+			return math.Cbrt(t)
+		}
+		return (24389.0/27*t + 16) / 116
+	}
+	fx, fy, fz := f(x), f(y), f(z)
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+// Get a color as hexadecimal string without "#", f.e. "ff4136"
+func colorHex(c Color) string {
+	rgba := colorRGBA(c)
+	return fmt.Sprintf("%02x%02x%02x", rgba.R, rgba.G, rgba.B)
+}
+
 // Prefix for ansi color
 func (ac AnsiColor) getPrefix(p Parser) string {
 	switch p.Name {
@@ -102,7 +158,7 @@ func (ac AnsiColor) getPrefix(p Parser) string {
 	case "terminal":
 		return fmt.Sprintf("%v[0;%dm", escape, ac.code)
 
-	case "html":
+	case "html", "svg":
 		// Get the TrueColor for the AnsiColor
 		tc := tcfac[ac]
 		return tc.getPrefix(p)
@@ -119,7 +175,7 @@ func (ac AnsiColor) getSuffix(p Parser) string {
 	case "terminal":
 		return fmt.Sprintf("%v[0m", escape)
 
-	case "html":
+	case "html", "svg":
 		// Get the TrueColor for the AnsiColor
 		tc := tcfac[ac]
 		return tc.getSuffix(p)
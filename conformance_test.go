@@ -0,0 +1,105 @@
+package figlet4go
+
+import (
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Regenerate the golden files with go test -run TestConformance -update
+// The reference figlet has to be in the PATH
+var update = flag.Bool("update", false, "update the golden files in testdata/golden with the output of figlet")
+
+// Strings rendered in every builtin font, by the name of their golden file
+var conformanceCorpus = []struct {
+	name string
+	text string
+}{
+	{"hello", "Hello, World!"},
+	{"upper", "ABCDEFGHIJKLMNOPQRSTUVWXYZ"},
+	{"lower", "abcdefghijklmnopqrstuvwxyz"},
+	{"digits", "0123456789"},
+	{"punctuation", "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"},
+	{"spaces", "  a  b  "},
+	{"german", "ÄÖÜäöüß"},
+	{"sentence", "The quick brown fox jumps over the lazy dog."},
+}
+
+// Layout modes of the renderer with the matching args of the reference figlet
+var conformanceLayouts = []struct {
+	name   string
+	layout Layout
+	args   []string
+}{
+	{"fullwidth", LayoutFullWidth, []string{"-W"}},
+	{"font", LayoutFont, []string{"-s"}},
+	{"kerning", LayoutKerning, []string{"-k"}},
+	{"smushing", LayoutSmushing, []string{"-S"}},
+	{"overlapping", LayoutOverlapping, []string{"-o"}},
+}
+
+func TestConformance(t *testing.T) {
+	ascii := NewAsciiRender()
+
+	// The golden files are only created by the reference figlet
+	figlet := ""
+	if *update {
+		var err error
+		if figlet, err = exec.LookPath("figlet"); err != nil {
+			t.Fatalf("-update needs the reference figlet: %v", err)
+		}
+	}
+
+	for _, font := range []string{"standard", "larry3d"} {
+		for _, layout := range conformanceLayouts {
+			for _, c := range conformanceCorpus {
+				name := font + "-" + layout.name + "-" + c.name
+				t.Run(name, func(t *testing.T) {
+					opt := NewRenderOptions()
+					opt.FontName = font
+					opt.Layout = layout.layout
+					got, err := ascii.RenderOpts(c.text, opt)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					path := filepath.Join("testdata", "golden", name+".txt")
+					if *update {
+						want := referenceFiglet(t, figlet, font, layout.args, c.text)
+						if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+							t.Fatal(err)
+						}
+						if err := os.WriteFile(path, []byte(want), 0644); err != nil {
+							t.Fatal(err)
+						}
+					}
+
+					want, err := os.ReadFile(path)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if got != string(want) {
+						t.Errorf("output differs from %s:\n%s\nwant:\n%s", path, got, want)
+					}
+				})
+			}
+		}
+	}
+}
+
+// Render a text with the reference figlet and the builtin font file
+// The text is read as UTF-8 by the control file in testdata, figlet
+// looks for control files without a path in the font directory
+func referenceFiglet(t *testing.T, figlet, font string, layoutArgs []string, text string) string {
+	args := append([]string{"-d", "assets", "-f", font, "-C", filepath.Join("testdata", "utf8.flc"), "-w", "10000"}, layoutArgs...)
+	cmd := exec.Command(figlet, append(args, "--", text)...)
+	cmd.Stdin = strings.NewReader("")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("%s: %v", figlet, err)
+	}
+	return string(out)
+}
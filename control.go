@@ -0,0 +1,498 @@
+package figlet4go
+
+// Explanation of FIGlet control files (.flc)
+//
+// Control files translate the input chars before they are looked up
+// in the font. Each line is a command, lines starting with # are comments:
+//
+//   t inchar(s) outchar(s)   Translate a char or a range (f.e. t a-z A-Z)
+//   number number            Translate the char with the first code to the second
+//   f                        Freeze: start a new stage, the following commands
+//                            are applied to the output of the previous ones
+//   u                        Decode the input as UTF-8 (default)
+//   b                        Decode the input as a double byte character set
+//   g{0-3} {94|96|94x94} c   Designate the charset c to G0-G3 (ISO 2022 mode)
+//   g{L|R} {0-3}             Invoke G0-G3 into the left or right half
+//
+// Chars can be given literally or escaped with a backslash: \a \b \e \f \n \r
+// \t \v \\, "\ " for a space or a number (decimal, octal or hexadecimal).
+// Within a stage the first matching translation is applied.
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// How the input string is decoded into chars
+type controlMode int
+
+const (
+	// The control file doesn't set a mode
+	modeUnset controlMode = iota
+	// Decode the input as UTF-8
+	modeUTF8
+	// Decode the input bytes with ISO 2022
+	modeISO2022
+	// A byte with the high bit set is combined with the following byte
+	modeDBCS
+)
+
+// Escape sequence and shift control chars of ISO 2022
+const (
+	iso2022Escape     byte = 0x1b
+	iso2022ShiftOut   byte = 0x0e
+	iso2022ShiftIn    byte = 0x0f
+	iso2022SingleShf2 byte = 0x8e
+	iso2022SingleShf3 byte = 0x8f
+)
+
+// A translation of a char range
+type controlMapping struct {
+	// First char of the input range
+	from rune
+	// First char of the output range
+	to rune
+	// Number of chars in the range
+	length rune
+}
+
+// Control is a parsed FIGlet control file (.flc)
+// It translates the input chars before they are looked up in the font
+type Control struct {
+	// Input decoding mode
+	mode controlMode
+	// Final chars of the charsets designated to G0-G3, 0 if not set
+	charsets [4]byte
+	// Whether G0-G3 are 94x94 double byte charsets
+	double [4]bool
+	// G set invoked into the left and right half, -1 if not set
+	gl, gr int
+	// Translation stages, separated by the f command
+	stages [][]controlMapping
+	// Unique number of the parsed control, part of the cache key
+	id uint64
+}
+
+// Number of the last parsed control
+var controlID atomic.Uint64
+
+// LoadControl loads a control file from disk
+func LoadControl(path string) (*Control, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseControl(f)
+}
+
+// ParseControl parses the contents of a control file
+func ParseControl(r io.Reader) (*Control, error) {
+	c := &Control{gl: -1, gr: -1, stages: [][]controlMapping{{}}, id: controlID.Add(1)}
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		if err := c.parseCommand(line); err != nil {
+			return nil, fmt.Errorf("Control file error in line %d: %v", lineNum, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Parse a single command line of a control file
+func (c *Control) parseCommand(line string) error {
+	stage := &c.stages[len(c.stages)-1]
+
+	switch cmd := line[0]; {
+	case cmd == 't':
+		from, rest, err := parseControlRange(strings.TrimLeft(line[1:], " \t"))
+		if err != nil {
+			return err
+		}
+		to, _, err := parseControlRange(strings.TrimLeft(rest, " \t"))
+		if err != nil {
+			return err
+		}
+		if to.length != from.length {
+			return errors.New("ranges of different length")
+		}
+		*stage = append(*stage, controlMapping{from.from, to.from, from.length})
+
+	case cmd == '-' || (cmd >= '0' && cmd <= '9'):
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return errors.New("expected two numbers")
+		}
+		from, err := parseControlNumber(fields[0])
+		if err != nil {
+			return err
+		}
+		to, err := parseControlNumber(fields[1])
+		if err != nil {
+			return err
+		}
+		*stage = append(*stage, controlMapping{from, to, 1})
+
+	case cmd == 'f':
+		c.stages = append(c.stages, []controlMapping{})
+
+	case cmd == 'u':
+		c.mode = modeUTF8
+
+	case cmd == 'b':
+		c.mode = modeDBCS
+
+	case cmd == 'g':
+		c.mode = modeISO2022
+		return c.parseDesignation(strings.Fields(line[1:]))
+
+	case cmd == 'h' || cmd == 'j':
+		return errors.New("unsupported input mode: " + string(cmd))
+
+	default:
+		return errors.New("unknown command: " + string(cmd))
+	}
+
+	return nil
+}
+
+// Parse the arguments of a g command
+// "0 94 B" designates a charset, "L 1" invokes G1 into the left half
+func (c *Control) parseDesignation(args []string) error {
+	if len(args) < 2 {
+		return errors.New("missing arguments to g")
+	}
+
+	switch target := strings.ToUpper(args[0]); target {
+	case "0", "1", "2", "3":
+		n := int(target[0] - '0')
+		switch args[1] {
+		case "94", "96":
+			c.double[n] = false
+		case "94x94":
+			c.double[n] = true
+		default:
+			return errors.New("invalid charset size: " + args[1])
+		}
+		if len(args) < 3 || len(args[2]) != 1 {
+			return errors.New("missing charset")
+		}
+		c.charsets[n] = args[2][0]
+
+	case "L", "R":
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 0 || n > 3 {
+			return errors.New("invalid G set: " + args[1])
+		}
+		if target == "L" {
+			c.gl = n
+		} else {
+			c.gr = n
+		}
+
+	default:
+		return errors.New("invalid g command: " + args[0])
+	}
+
+	return nil
+}
+
+// Parse a char or a char range (a-z) of a t command
+// Returns the range with its first char and the rest of the string
+func parseControlRange(s string) (controlMapping, string, error) {
+	first, rest, err := parseControlChar(s)
+	if err != nil {
+		return controlMapping{}, "", err
+	}
+
+	if len(rest) < 2 || rest[0] != '-' {
+		return controlMapping{first, first, 1}, rest, nil
+	}
+
+	last, rest, err := parseControlChar(rest[1:])
+	if err != nil {
+		return controlMapping{}, "", err
+	}
+	if last < first {
+		return controlMapping{}, "", errors.New("invalid range")
+	}
+
+	return controlMapping{first, first, last - first + 1}, rest, nil
+}
+
+// Parse a single, possibly escaped, char
+// Returns the char and the rest of the string
+func parseControlChar(s string) (rune, string, error) {
+	if s == "" {
+		return 0, "", errors.New("missing char")
+	}
+
+	if s[0] != '\\' {
+		r, size := utf8.DecodeRuneInString(s)
+		return r, s[size:], nil
+	}
+
+	if len(s) < 2 {
+		return 0, "", errors.New("incomplete escape")
+	}
+
+	// Numeric escape
+	if s[1] == '-' || (s[1] >= '0' && s[1] <= '9') {
+		end := 2
+		for end < len(s) && strings.IndexByte("0123456789abcdefABCDEFxX", s[end]) >= 0 {
+			end++
+		}
+		code, err := parseControlNumber(s[1:end])
+		return code, s[end:], err
+	}
+
+	escapes := map[byte]rune{
+		'a': '\a', 'b': '\b', 'e': 0x1b, 'f': '\f', 'n': '\n',
+		'r': '\r', 't': '\t', 'v': '\v', '\\': '\\', ' ': ' ',
+	}
+	if r, ok := escapes[s[1]]; ok {
+		return r, s[2:], nil
+	}
+
+	// Any other escaped char stands for itself
+	r, size := utf8.DecodeRuneInString(s[1:])
+	return r, s[1+size:], nil
+}
+
+// Parse a decimal, octal (leading 0) or hexadecimal (leading 0x) number
+func parseControlNumber(s string) (rune, error) {
+	code, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, errors.New("invalid number: " + s)
+	}
+	return rune(code), nil
+}
+
+// Translate a char with the first matching mapping of a stage
+func translateControlStage(stage []controlMapping, char rune) rune {
+	for _, m := range stage {
+		if char >= m.from && char < m.from+m.length {
+			return m.to + char - m.from
+		}
+	}
+	return char
+}
+
+// Decode the input string and apply the translations of all controls in order
+func applyControls(str string, controls []*Control) []rune {
+	// ISO 2022 defaults: G0 ASCII, G1 Latin-1, G2 and G3 ASCII, G0 left, G1 right
+	iso := iso2022State{charsets: [4]byte{'B', 'A', 'B', 'B'}, gl: 0, gr: 1}
+	mode := modeUTF8
+
+	// Later control files override the mode and designations of earlier ones
+	for _, c := range controls {
+		if c.mode != modeUnset {
+			mode = c.mode
+		}
+		for n, cs := range c.charsets {
+			if cs != 0 {
+				iso.charsets[n] = cs
+				iso.double[n] = c.double[n]
+			}
+		}
+		if c.gl >= 0 {
+			iso.gl = c.gl
+		}
+		if c.gr >= 0 {
+			iso.gr = c.gr
+		}
+	}
+
+	var chars []rune
+	switch mode {
+	case modeISO2022:
+		chars = iso.decode([]byte(str))
+	case modeDBCS:
+		chars = decodeDBCS([]byte(str))
+	default:
+		chars = []rune(str)
+	}
+
+	for _, c := range controls {
+		for _, stage := range c.stages {
+			for i, char := range chars {
+				chars[i] = translateControlStage(stage, char)
+			}
+		}
+	}
+
+	return chars
+}
+
+// Combine a byte with the high bit set with the following byte
+func decodeDBCS(b []byte) []rune {
+	chars := make([]rune, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] >= 0x80 && i+1 < len(b) {
+			chars = append(chars, rune(b[i])<<8|rune(b[i+1]))
+			i++
+			continue
+		}
+		chars = append(chars, rune(b[i]))
+	}
+	return chars
+}
+
+// State of the ISO 2022 decoder
+type iso2022State struct {
+	// Final chars of the charsets designated to G0-G3
+	charsets [4]byte
+	// Whether G0-G3 are 94x94 double byte charsets
+	double [4]bool
+	// G sets invoked into the left and right half
+	gl, gr int
+}
+
+// Decode bytes with ISO 2022
+// Chars of the ASCII charset (B) keep their code, chars of the Latin-1
+// right half (A) get the high bit set, chars of other charsets are
+// 65536 * charset + code, as figlet does
+func (st iso2022State) decode(b []byte) []rune {
+	chars := make([]rune, 0, len(b))
+	// G set for the next char only, -1 if none
+	single := -1
+
+	for i := 0; i < len(b); i++ {
+		ch := b[i]
+
+		switch {
+		case ch == iso2022Escape:
+			i += st.escape(b[i+1:], &single)
+			continue
+		case ch == iso2022ShiftOut:
+			st.gl = 1
+			continue
+		case ch == iso2022ShiftIn:
+			st.gl = 0
+			continue
+		case ch == iso2022SingleShf2:
+			single = 2
+			continue
+		case ch == iso2022SingleShf3:
+			single = 3
+			continue
+		}
+
+		// Control chars, space and delete are used as they are
+		low := ch & 0x7f
+		if low <= 0x20 || low == 0x7f {
+			chars = append(chars, rune(ch))
+			continue
+		}
+
+		g := st.gl
+		if ch >= 0x80 {
+			g = st.gr
+		}
+		if single >= 0 {
+			g = single
+			single = -1
+		}
+
+		cs := st.charsets[g]
+		code := rune(low)
+		if st.double[g] {
+			if i+1 >= len(b) {
+				break
+			}
+			i++
+			code = code<<8 | rune(b[i]&0x7f)
+		}
+
+		switch {
+		case cs == 'B' && !st.double[g]:
+			chars = append(chars, code)
+		case cs == 'A' && !st.double[g]:
+			chars = append(chars, code|0x80)
+		default:
+			chars = append(chars, rune(cs)<<16|code)
+		}
+	}
+
+	return chars
+}
+
+// Handle an escape sequence following an ESC
+// Returns the number of bytes the sequence used
+func (st *iso2022State) escape(seq []byte, single *int) int {
+	if len(seq) < 1 {
+		return 0
+	}
+
+	// Invocations and single shifts
+	switch seq[0] {
+	case 'N':
+		*single = 2
+		return 1
+	case 'O':
+		*single = 3
+		return 1
+	case 'n':
+		st.gl = 2
+		return 1
+	case 'o':
+		st.gl = 3
+		return 1
+	case '~':
+		st.gr = 1
+		return 1
+	case '}':
+		st.gr = 2
+		return 1
+	case '|':
+		st.gr = 3
+		return 1
+	}
+
+	// Designations of 94 and 96 char sets
+	sets94 := map[byte]int{'(': 0, ')': 1, '*': 2, '+': 3}
+	sets96 := map[byte]int{',': 0, '-': 1, '.': 2, '/': 3}
+
+	if len(seq) < 2 {
+		return len(seq)
+	}
+	if n, ok := sets94[seq[0]]; ok {
+		st.charsets[n], st.double[n] = seq[1], false
+		return 2
+	}
+	if n, ok := sets96[seq[0]]; ok {
+		st.charsets[n], st.double[n] = seq[1], false
+		return 2
+	}
+
+	// Designations of 94x94 sets: ESC $ F designates G0, ESC $ ( F ... ESC $ + F
+	if seq[0] == '$' {
+		if n, ok := sets94[seq[1]]; ok && len(seq) > 2 {
+			st.charsets[n], st.double[n] = seq[2], true
+			return 3
+		}
+		st.charsets[0], st.double[0] = seq[1], true
+		return 2
+	}
+
+	return 1
+}
@@ -0,0 +1,76 @@
+package figlet4go
+
+import (
+	"strings"
+	"testing"
+)
+
+func parseTestControl(t *testing.T, cont string) *Control {
+	t.Helper()
+	c, err := ParseControl(strings.NewReader(cont))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestApplyControls(t *testing.T) {
+	tests := []struct {
+		name    string
+		control string
+		input   string
+		want    string
+	}{
+		{"upper", "# upper.flc\nt a-z A-Z\n", "Hello!", "HELLO!"},
+		{"numbers", "0x41 66\n", "AAB", "BBB"},
+		{"escapes", "t \\  _\nt \\0x21 ?\n", "a b!", "a_b?"},
+		// Within a stage the first match wins, f starts a new stage
+		{"stages", "t a b\nt b c\nf\nt b d\n", "ab", "dc"},
+		{"iso2022", "g1 96 A\ngR 1\n", "x\xe9\x1b-B\xe9", "xéi"},
+	}
+
+	for _, test := range tests {
+		c := parseTestControl(t, test.control)
+		got := string(applyControls(test.input, []*Control{c}))
+		if got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestApplyControlsISO2022Charsets(t *testing.T) {
+	// Designate a 94 char set to G1 and shift it in
+	c := parseTestControl(t, "g1 94 K\n")
+	got := applyControls("a\x0eb\x0fc", []*Control{c})
+	want := []rune{'a', 'K'<<16 | 'b', 'c'}
+	if string(got) != string(want) {
+		t.Errorf("got %U, want %U", got, want)
+	}
+}
+
+func TestParseControlErrors(t *testing.T) {
+	for _, cont := range []string{"t a-c x", "x", "g5 94 B", "h", "t"} {
+		if _, err := ParseControl(strings.NewReader(cont)); err == nil {
+			t.Errorf("expected an error for %q", cont)
+		}
+	}
+}
+
+func TestRenderOptsControls(t *testing.T) {
+	ascii := NewAsciiRender()
+
+	opt := NewRenderOptions()
+	opt.Controls = []*Control{parseTestControl(t, "t a-z A-Z\n")}
+	got, err := ascii.RenderOpts("upper", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := ascii.Render("UPPER")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
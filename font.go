@@ -20,34 +20,366 @@ package figlet4go
 //
 
 import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
-// Represents a single font
-type font struct {
-	// Hardblank symbol
-	hardblank string
+// Codes of the chars every font must contain in this order:
+// the printable ascii chars followed by the german chars
+var requiredChars []rune = requiredCharCodes()
+
+// Build the list of required char codes
+func requiredCharCodes() []rune {
+	codes := []rune{}
+	for c := rune(32); c <= 126; c++ {
+		codes = append(codes, c)
+	}
+	// Ä Ö Ü ä ö ü ß
+	return append(codes, 196, 214, 220, 228, 246, 252, 223)
+}
+
+// Font is a single parsed FIGfont (or TOIlet font)
+// The header fields are explained on top
+type Font struct {
+	// Hardblank symbol, changing it doesn't affect chars already added
+	Hardblank string
 	// Height of one char
-	height int
-	// A string for each line of the char
-	fontSlice []string
+	Height int
+	// Lines from the top of a char to the baseline
+	Baseline int
+	// Length of the longest line including the endmarks
+	MaxLength int
+	// Layout in the old format (-1 full width, 0 kerning, >0 smushing rules)
+	OldLayout int
+	// 0 left-to-right, 1 right-to-left
+	PrintDirection int
+	// Layout in the new format, derived from OldLayout if the font doesn't set it
+	FullLayout int
+	// Comment lines following the header
+	Comments []string
+	// TOIlet font (tlf2a signature)
+	Toilet bool
+	// The chars, decoded when they are added
+	glyphs map[rune]*glyph
+	// Description following the code in the code tag lines
+	codeTags map[rune]string
+}
+
+// NewFont creates an empty font with the given height
+// Chars are added with SetChar
+func NewFont(height int) *Font {
+	return &Font{
+		Hardblank: "$",
+		Height:    height,
+		Baseline:  height,
+		OldLayout: -1,
+		glyphs:    make(map[rune]*glyph),
+		codeTags:  make(map[rune]string),
+	}
+}
+
+// ParseFont reads a FIGfont or TOIlet font, zip compressed fonts are decompressed
+// Fonts with an incomplete required char are rejected
+func ParseFont(r io.Reader) (*Font, error) {
+	cont, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return parseFontContent(string(cont))
+}
+
+// Chars returns the codes of all chars in the font, sorted
+func (f *Font) Chars() []rune {
+	codes := make([]rune, 0, len(f.glyphs))
+	for code := range f.glyphs {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i] < codes[j]
+	})
+	return codes
+}
+
+// Char returns the lines of a char without endmarks, hardblanks are not replaced
+func (f *Font) Char(code rune) ([]string, bool) {
+	g, ok := f.glyphs[code]
+	if !ok {
+		return nil, false
+	}
+	return append([]string{}, g.raw...), true
+}
+
+// SetChar adds or replaces a char
+// The description is written to the code tag line of non required chars
+func (f *Font) SetChar(code rune, lines []string, description string) error {
+	if len(lines) != f.Height {
+		return fmt.Errorf("Char %U has %d lines, the font height is %d", code, len(lines), f.Height)
+	}
+	f.glyphs[code] = newGlyph(padCharLines(append([]string{}, lines...)), f.Hardblank)
+	delete(f.codeTags, code)
+	if description != "" {
+		f.codeTags[code] = description
+	}
+	return nil
 }
 
-// Get a slice of strings containing the chars lines
-func (f *font) getCharSlice(char rune) []string {
+// Get the glyph of a char
+// If the font doesn't contain the char the char with the code 0
+// is used (as figlet does), ok is false if this doesn't exist either
+func (f *Font) glyph(char rune) (*glyph, bool) {
+	g, ok := f.glyphs[char]
+	if !ok {
+		g, ok = f.glyphs[0]
+	}
+	return g, ok
+}
 
-	height := f.height
-	beginRow := (int(char) - 32) * height
+// Read the chars from the lines following the comments
+// First the required chars, which must be complete, then the code
+// tagged chars, parsing stops at the end of the lines or a incomplete char
+// Returns the chars and the descriptions of the code tags
+func parseChars(lines []string, height int, hardblank string) (map[rune]*glyph, map[rune]string, error) {
+	chars := make(map[rune]*glyph)
+	codeTags := make(map[rune]string)
+
+	// Read the lines of the char starting at the given line
+	readChar := func(begin int) (*glyph, bool) {
+		if height < 1 || begin+height > len(lines) {
+			return nil, false
+		}
+		charLines := make([]string, height)
+		for i := range charLines {
+			charLines[i] = trimEndmarks(lines[begin+i])
+		}
+		return newGlyph(padCharLines(charLines), hardblank), true
+	}
 
-	lines := make([]string, height)
+	cur := 0
+	for _, code := range requiredChars {
+		g, ok := readChar(cur)
+		if !ok {
+			return nil, nil, fmt.Errorf("Font content error: incomplete required char %U", code)
+		}
+		chars[code] = g
+		cur += height
+	}
 
-	// Get the char lines of the char
-	for i := 0; i < height; i++ {
-		row := f.fontSlice[beginRow+i]
-		row = strings.Replace(row, "@", "", -1)
-		row = strings.Replace(row, f.hardblank, " ", -1)
-		lines[i] = row
+	// Code tagged chars, the tag line is followed by the char
+	for cur < len(lines) {
+		code, description, ok := parseCodeTag(lines[cur])
+		if !ok {
+			break
+		}
+		g, ok := readChar(cur + 1)
+		if !ok {
+			break
+		}
+		chars[code] = g
+		codeTags[code] = description
+		cur += height + 1
 	}
 
+	return chars, codeTags, nil
+}
+
+// Remove trailing whitespace and the endmarks of a char line
+// The endmark is the last char of the line, it may be repeated
+// TOIlet fonts are UTF-8, so the endmark may be any rune
+func trimEndmarks(line string) string {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" {
+		return line
+	}
+	endmark, _ := utf8.DecodeLastRuneInString(line)
+	return strings.TrimRight(line, string(endmark))
+}
+
+// Pad the lines of a char with spaces to the width of the widest line
+// The width is counted in display columns, not bytes or runes,
+// for the UTF-8 glyphs of TOIlet fonts (wide and combining chars)
+func padCharLines(lines []string) []string {
+	width := 0
+	for _, line := range lines {
+		width = max(width, stringWidth(line))
+	}
+	for i, line := range lines {
+		lines[i] = line + strings.Repeat(" ", width-stringWidth(line))
+	}
 	return lines
 }
+
+// Parse a code tag line like "196  LATIN CAPITAL LETTER A WITH DIAERESIS"
+// The code may be decimal, octal (leading 0) or hexadecimal (leading 0x)
+// Returns the code and the description following it
+func parseCodeTag(line string) (rune, string, bool) {
+	line = strings.TrimSpace(line)
+	codeStr, description := line, ""
+	if i := strings.IndexAny(line, " \t"); i >= 0 {
+		codeStr, description = line[:i], line[i:]
+	}
+	code, err := strconv.ParseInt(codeStr, 0, 32)
+	if err != nil {
+		return 0, "", false
+	}
+	return rune(code), strings.TrimSpace(description), true
+}
+
+// Derive the full layout from the old layout as described in the spec
+func fullLayoutFromOld(oldLayout int) int {
+	switch {
+	case oldLayout < 0:
+		// Full width
+		return 0
+	case oldLayout == 0:
+		// Horizontal kerning
+		return 64
+	}
+	// Horizontal smushing with the given rules
+	return (oldLayout & 63) | 128
+}
+
+// Parse the contents of a font after decompression
+func parseFont(cont string) (*Font, error) {
+	// Get all lines, the newline at the end doesn't start another one
+	lines := strings.Split(strings.TrimSuffix(cont, "\n"), "\n")
+
+	// FIGlet and TOIlet fonts only differ in the signature,
+	// the hardblank directly follows it
+	header := lines[0]
+	toilet := strings.HasPrefix(header, toiletSignature)
+	if !strings.HasPrefix(header, signature) && !toilet {
+		return nil, errors.New("Font content error: invalid signature")
+	}
+	hardblank, size := utf8.DecodeRuneInString(header[len(signature):])
+	if size == 0 {
+		return nil, errors.New("Font content error: missing hardblank")
+	}
+
+	// Get the header metadata
+	// Height, baseline, max length, old layout and comment lines are required
+	fields := strings.Fields(header[len(signature)+size:])
+	nums := make([]int, len(fields))
+	for i, field := range fields {
+		num, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, errors.New("Font content error: invalid header value " + field)
+		}
+		nums[i] = num
+	}
+	if len(nums) < 5 {
+		return nil, errors.New("Font content error: incomplete header")
+	}
+
+	height, commentLines := nums[0], nums[4]
+	if height < 1 || commentLines < 0 || 1+commentLines > len(lines) {
+		return nil, errors.New("Font content error: invalid header")
+	}
+	// Checked before anything of the font height is allocated
+	if height > len(lines)-1-commentLines {
+		return nil, errors.New("Font content error: height exceeds the number of lines")
+	}
+
+	// Initialize the font
+	font := &Font{
+		Hardblank:  string(hardblank),
+		Height:     height,
+		Baseline:   nums[1],
+		MaxLength:  nums[2],
+		OldLayout:  nums[3],
+		FullLayout: fullLayoutFromOld(nums[3]),
+		Toilet:     toilet,
+	}
+	if len(nums) > 5 {
+		font.PrintDirection = nums[5]
+	}
+	if len(nums) > 6 {
+		font.FullLayout = nums[6]
+	}
+	for _, line := range lines[1 : 1+commentLines] {
+		font.Comments = append(font.Comments, strings.TrimRight(line, "\r"))
+	}
+	var err error
+	font.glyphs, font.codeTags, err = parseChars(lines[1+commentLines:], height, font.Hardblank)
+	if err != nil {
+		return nil, err
+	}
+
+	return font, nil
+}
+
+// WriteFLF writes the font in the FIGfont format
+// Required chars missing in the font are written empty,
+// all others are written with code tags
+func (f *Font) WriteFLF(w io.Writer) error {
+	// Lines of an empty char
+	blank := make([]string, f.Height)
+
+	required := make(map[rune]bool)
+	for _, code := range requiredChars {
+		required[code] = true
+	}
+	// Required chars which were code tagged (f.e. the german chars) are tagged again
+	tagged := []rune{}
+	for _, code := range f.Chars() {
+		if _, hasTag := f.codeTags[code]; !required[code] || hasTag {
+			tagged = append(tagged, code)
+		}
+	}
+
+	// The max length includes the endmarks
+	maxLength := f.MaxLength
+	for _, g := range f.glyphs {
+		for _, line := range g.raw {
+			maxLength = max(maxLength, utf8.RuneCountInString(line)+2)
+		}
+	}
+
+	sig := signature
+	if f.Toilet {
+		sig = toiletSignature
+	}
+
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "%s%s %d %d %d %d %d %d %d %d\n",
+		sig, f.Hardblank, f.Height, f.Baseline, maxLength, f.OldLayout,
+		len(f.Comments), f.PrintDirection, f.FullLayout, len(tagged))
+	for _, line := range f.Comments {
+		fmt.Fprintln(bw, line)
+	}
+
+	for _, code := range requiredChars {
+		lines := blank
+		if g, ok := f.glyphs[code]; ok {
+			lines = g.raw
+		}
+		writeCharLines(bw, lines)
+	}
+	for _, code := range tagged {
+		fmt.Fprintln(bw, strings.TrimSpace(strconv.Itoa(int(code))+"  "+f.codeTags[code]))
+		writeCharLines(bw, f.glyphs[code].raw)
+	}
+
+	return bw.Flush()
+}
+
+// Write the lines of a char with endmarks, two on the last line
+// The endmark is @ unless the line ends with it
+func writeCharLines(w io.Writer, lines []string) {
+	for i, line := range lines {
+		endmark := "@"
+		if strings.HasSuffix(line, "@") {
+			endmark = "#"
+		}
+		if i == len(lines)-1 {
+			endmark += endmark
+		}
+		fmt.Fprintln(w, line+endmark)
+	}
+}
@@ -0,0 +1,230 @@
+package figlet4go
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// Build a small TOIlet font with UTF-8 glyphs
+// Every required char is a block, followed by a code tagged star
+func testToiletFont() string {
+	var b strings.Builder
+	// The hardblank is a multi-byte no-break space
+	b.WriteString("tlf2a\u00a0 2 2 4 -1 1\n")
+	b.WriteString("test font with UTF-8 glyphs\n")
+
+	for _, code := range requiredChars {
+		switch code {
+		case ' ':
+			b.WriteString("\u00a0@\n\u00a0@@\n")
+		case 'A':
+			// Rows of different width are padded
+			b.WriteString("▄▀▄@\n█▀@@\n")
+		default:
+			b.WriteString("██@\n██@@\n")
+		}
+	}
+
+	b.WriteString("0x2605  BLACK STAR\n")
+	b.WriteString("░★░#\n★░★##\n")
+
+	// Wide and combining chars are padded by display width
+	b.WriteString("0x4E2D  CJK\n")
+	b.WriteString("中@\nёe\u0301e\u0301@@\n")
+
+	return b.String()
+}
+
+func TestToiletFont(t *testing.T) {
+	fsys := fstest.MapFS{"fonts/block.tlf": {Data: []byte(testToiletFont())}}
+
+	ascii := NewAsciiRender()
+	if err := ascii.LoadFontFS(fsys, "fonts"); err != nil {
+		t.Fatal(err)
+	}
+
+	opt := NewRenderOptions()
+	opt.FontName = "block"
+	got, err := ascii.RenderOpts("A ★", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "▄▀▄ ░★░\n█▀  ★░★\n"
+	if got != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", got, want)
+	}
+
+	// Combining marks in the input are skipped
+	got, err = ascii.RenderOpts("中\u0301A", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "中 ▄▀▄\nёe\u0301e\u0301█▀ \n"
+	if got != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestParseFontContentSignature(t *testing.T) {
+	if _, err := parseFontContent("xyz2a$ 1 1 1 -1 0\n"); err == nil {
+		t.Error("expected an error for an invalid signature")
+	}
+}
+
+func TestParseFontContentIncomplete(t *testing.T) {
+	// A font of all required chars but the last
+	var b strings.Builder
+	b.WriteString("flf2a$ 1 1 3 -1 0\n")
+	for range requiredChars[1:] {
+		b.WriteString("x@@\n")
+	}
+
+	for _, cont := range []string{
+		// The height is larger than the font
+		"flf2a$ 100000000 1 1 0 0\n",
+		b.String(),
+	} {
+		if _, err := parseFontContent(cont); err == nil {
+			t.Errorf("expected an error for %.30q", cont)
+		}
+	}
+	if _, err := parseFontContent(b.String() + "x@@\n"); err != nil {
+		t.Errorf("complete font: %v", err)
+	}
+}
+
+func TestWriteFLFRoundTrip(t *testing.T) {
+	for _, name := range defaultFonts {
+		data, err := os.ReadFile(filepath.Join("assets", name+"."+extension))
+		if err != nil {
+			t.Fatal(err)
+		}
+		font, err := ParseFont(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var written bytes.Buffer
+		if err := font.WriteFLF(&written); err != nil {
+			t.Fatal(err)
+		}
+		reparsed, err := ParseFont(bytes.NewReader(written.Bytes()))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !reflect.DeepEqual(font, reparsed) {
+			t.Errorf("%s: font changed after writing and parsing it again", name)
+		}
+
+		// Writing again gives the same output
+		var rewritten bytes.Buffer
+		if err := reparsed.WriteFLF(&rewritten); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(written.Bytes(), rewritten.Bytes()) {
+			t.Errorf("%s: output not stable", name)
+		}
+	}
+}
+
+func TestWriteFLFHeader(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("assets", "standard."+extension))
+	if err != nil {
+		t.Fatal(err)
+	}
+	font, err := ParseFont(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := font.WriteFLF(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+
+	// Header with all fields and the code tag count
+	if want := "flf2a$ 6 5 16 15 11 0 24463 229"; lines[0] != want {
+		t.Errorf("unexpected header %q, want %q", lines[0], want)
+	}
+	if lines[1] != font.Comments[0] {
+		t.Errorf("unexpected first comment %q", lines[1])
+	}
+	// The space with hardblanks and endmarks
+	if lines[12] != " $@" || lines[17] != " $@@" {
+		t.Errorf("unexpected space char %q %q", lines[12], lines[17])
+	}
+}
+
+func TestSetChar(t *testing.T) {
+	font := NewFont(2)
+	if err := font.SetChar('x', []string{"x@"}, ""); err == nil {
+		t.Error("expected an error for the wrong number of lines")
+	}
+	// Lines ending with @ get another endmark
+	if err := font.SetChar(0x263A, []string{"@@", ":)"}, "SMILE"); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := font.WriteFLF(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(buf.String(), "9786  SMILE\n@@#\n:)@@\n") {
+		t.Errorf("unexpected code tagged char:\n%s", buf.String()[len(buf.String())-30:])
+	}
+
+	parsed, err := ParseFont(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines, ok := parsed.Char(0x263A); !ok || !reflect.DeepEqual(lines, []string{"@@", ":)"}) {
+		t.Errorf("unexpected char after parsing: %q", lines)
+	}
+}
+
+func TestGlyph(t *testing.T) {
+	font := NewFont(3)
+	if err := font.SetChar('x', []string{"  $ ", "\\/", ""}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	g, ok := font.glyph('x')
+	if !ok {
+		t.Fatal("missing glyph")
+	}
+	if want := []string{"    ", "\\/  ", "    "}; !reflect.DeepEqual(g.rows, want) {
+		t.Errorf("rows %q, want %q", g.rows, want)
+	}
+	if want := []string{"  $ ", "\\/  ", "    "}; !reflect.DeepEqual(g.raw, want) {
+		t.Errorf("raw %q, want %q", g.raw, want)
+	}
+	if g.width != 4 {
+		t.Errorf("width %d, want 4", g.width)
+	}
+	// Hardblanks are not blank
+	if want := []int{2, 0, 4}; !reflect.DeepEqual(g.left, want) {
+		t.Errorf("left %v, want %v", g.left, want)
+	}
+	if want := []int{1, 2, 4}; !reflect.DeepEqual(g.right, want) {
+		t.Errorf("right %v, want %v", g.right, want)
+	}
+	if want := []rune("\\/  "); !reflect.DeepEqual(g.cells[1], want) {
+		t.Errorf("cells %q, want %q", g.cells[1], want)
+	}
+
+	// Unknown chars use char 0 if the font has it
+	if _, ok := font.glyph('y'); ok {
+		t.Error("expected no glyph for y")
+	}
+	font.SetChar(0, []string{"?", "?", "?"}, "")
+	if g, ok := font.glyph('y'); !ok || g.rows[0] != "?" {
+		t.Errorf("expected the glyph of char 0, got %v", g)
+	}
+}
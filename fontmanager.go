@@ -1,12 +1,16 @@
 package figlet4go
 
 import (
+	"archive/zip"
+	"embed"
 	"errors"
-	"io/ioutil"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
-	"strconv"
+	"sort"
 	"strings"
+	"sync"
 )
 
 // Default font if no other valid given
@@ -15,18 +19,91 @@ const defaultFont string = "standard"
 // Extension of a font file
 const extension string = "flf"
 
+// Extension of a TOIlet font file
+const toiletExtension string = "tlf"
+
+// Signatures at the beginning of FIGlet and TOIlet fonts
+const (
+	signature       string = "flf2a"
+	toiletSignature string = "tlf2a"
+)
+
+// Signature of a zip archive (local file header)
+// FIGfonts may be zip compressed, the first file is the font
+const zipSignature string = "PK\x03\x04"
+
+// Maximum size of a decompressed font, the biggest fonts have a few MB
+const maxUnzippedSize int64 = 16 << 20
+
 // Builtin fonts to load
 var defaultFonts []string = []string{
 	"standard",
 	"larry3d",
 }
 
+// Builtin font files, embedded from the assets directory
+//
+//go:embed assets/*.flf
+var builtinFonts embed.FS
+
+// FontSource describes where a font was loaded from
+type FontSource string
+
+// Possible font sources
+const (
+	// Embedded in the library
+	FontSourceBuiltin FontSource = "builtin"
+	// Found by LoadFont in a path on disk
+	FontSourceDisk FontSource = "disk"
+	// Found by LoadFontFS in a fs.FS
+	FontSourceFS FontSource = "fs"
+	// Given to LoadBindataFont
+	FontSourceBindata FontSource = "bindata"
+)
+
+// FontInfo describes a font known to the AsciiRender
+type FontInfo struct {
+	// Name of the font as used in RenderOptions
+	Name string
+	// Where the font comes from
+	Source FontSource
+	// Path of the font file, empty for bindata fonts
+	Path string
+	// Whether the font is already parsed
+	Loaded bool
+	// Height of a char, 0 if the font is not loaded
+	Height int
+}
+
+// Location of a font file
+type fontFile struct {
+	// Where the font file comes from
+	source FontSource
+	// Filesystem the font is stored in, nil for the os filesystem
+	fsys fs.FS
+	// Path of the font file
+	path string
+}
+
 // Holds the available fonts
+// Safe for concurrent use, fonts are loaded lazily outside the lock
+// so rendering with loaded fonts doesn't wait for them
 type fontManager struct {
+	// Guards fontLib, fontList and loading
+	mu sync.RWMutex
 	// The already read fonts
-	fontLib map[string]*font
+	fontLib map[string]*Font
 	// The in given pathes found fonts
-	fontList map[string]string
+	fontList map[string]fontFile
+	// Fonts currently read from their files, one load per name
+	loading map[string]*fontLoad
+}
+
+// A font being loaded, done is closed when font and err are set
+type fontLoad struct {
+	done chan struct{}
+	font *Font
+	err  error
 }
 
 // Create a new fontmanager
@@ -34,29 +111,90 @@ type fontManager struct {
 // loads the builtin fonts and returns it
 func newFontManager() *fontManager {
 	fm := &fontManager{
-		fontLib:  make(map[string]*font),
-		fontList: make(map[string]string),
+		fontLib:  make(map[string]*Font),
+		fontList: make(map[string]fontFile),
+		loading:  make(map[string]*fontLoad),
 	}
 	fm.loadBuildInFont()
 	return fm
 }
 
+// ErrFontNotFound is returned if a font is neither loaded
+// nor found in one of the loaded font paths
+type ErrFontNotFound struct {
+	// Name of the requested font
+	Name string
+	// Known fonts with a similar name, closest first
+	Suggestions []string
+}
+
+// Error message with the suggestions if there are any
+func (e *ErrFontNotFound) Error() string {
+	msg := "Font Not Found: " + e.Name
+	if len(e.Suggestions) > 0 {
+		msg += " (did you mean " + strings.Join(e.Suggestions, ", ") + "?)"
+	}
+	return msg
+}
+
 // Get a font by name
-// Default font if no other font could be loaded
-func (fm *fontManager) getFont(fontName string) *font {
-	// Get the font from the fontLib
-	_, ok := fm.fontLib[fontName]
-	// Font not found
+// The default font is used if the name is empty
+func (fm *fontManager) getFont(fontName string) (*Font, error) {
+	if fontName == "" {
+		fontName = defaultFont
+	}
+
+	// Fast path: the font is already parsed
+	fm.mu.RLock()
+	font, ok := fm.fontLib[fontName]
+	fm.mu.RUnlock()
+	if ok {
+		return font, nil
+	}
+
+	fm.mu.Lock()
+	// Another goroutine may have loaded it in the meantime
+	if font, ok := fm.fontLib[fontName]; ok {
+		fm.mu.Unlock()
+		return font, nil
+	}
+	// Or is loading it right now
+	if load, ok := fm.loading[fontName]; ok {
+		fm.mu.Unlock()
+		<-load.done
+		return load.font, load.err
+	}
+	// Try to load it from loaded fontList
+	file, ok := fm.fontList[fontName]
 	if !ok {
-		// Try to load it from loaded fontList
-		err := fm.loadDiskFont(fontName)
-		// Font also not found here, use the default font
-		if err != nil {
-			fontName = defaultFont
+		err := &ErrFontNotFound{
+			Name:        fontName,
+			Suggestions: fm.similarFontNames(fontName),
+		}
+		fm.mu.Unlock()
+		return nil, err
+	}
+	load := &fontLoad{done: make(chan struct{})}
+	fm.loading[fontName] = load
+	fm.mu.Unlock()
+
+	// Read and parse the file without holding the lock
+	load.font, load.err = file.load()
+
+	fm.mu.Lock()
+	delete(fm.loading, fontName)
+	if load.err == nil {
+		// A font loaded by LoadBindataFont in the meantime wins
+		if font, ok := fm.fontLib[fontName]; ok {
+			load.font = font
+		} else {
+			fm.fontLib[fontName] = load.font
 		}
 	}
+	fm.mu.Unlock()
+	close(load.done)
 
-	return fm.fontLib[fontName]
+	return load.font, load.err
 }
 
 // Loads all .flf files recursively in the fontPath path
@@ -64,6 +202,10 @@ func (fm *fontManager) getFont(fontName string) *font {
 // and the path as the value. Doesn't load them at this point
 // for performance. Called in the AsciiRenderer
 func (fm *fontManager) loadFontList(fontPath string) error {
+	// Walk without holding the lock, the found files are registered at the end
+	files := make(map[string]fontFile)
+	defer fm.registerFontFiles(files)
+
 	// Walk through the path
 	return filepath.Walk(fontPath, func(path string, info os.FileInfo, err error) error {
 		// Return an error if occurred
@@ -71,59 +213,213 @@ func (fm *fontManager) loadFontList(fontPath string) error {
 			return err
 		}
 		// If the current item is a directory or has not the correct suffix
-		if info.IsDir() || !strings.HasSuffix(info.Name(), "."+extension) {
+		if info.IsDir() {
+			return nil
+		}
+		// Extract the font name, skip files with the wrong suffix
+		fontName, ok := fontNameFromFile(info.Name())
+		if !ok {
 			return nil
 		}
-		// Extract the font name
-		fontName := strings.TrimSuffix(info.Name(), "."+extension)
 		// Save the font to the list
-		fm.fontList[fontName] = path
+		files[fontName] = fontFile{source: FontSourceDisk, path: path}
 
 		return nil
 	})
 }
 
-// Load a font from disk
-// The font must be registered in the fontList
-func (fm *fontManager) loadDiskFont(fontName string) error {
-	// Get the fontpath
-	path, ok := fm.fontList[fontName]
-	// Font is not registered
-	if !ok {
-		return errors.New("Font Not Found: " + fontName)
+// Same as loadFontList but walks root in the given filesystem
+func (fm *fontManager) loadFontListFS(fsys fs.FS, root string) error {
+	files := make(map[string]fontFile)
+	defer fm.registerFontFiles(files)
+
+	// Walk through the filesystem
+	return fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error {
+		// Return an error if occurred
+		if err != nil {
+			return err
+		}
+		// If the current item is a directory or has not the correct suffix
+		if d.IsDir() {
+			return nil
+		}
+		// Extract the font name, skip files with the wrong suffix
+		fontName, ok := fontNameFromFile(d.Name())
+		if !ok {
+			return nil
+		}
+		// Save the font to the list
+		files[fontName] = fontFile{source: FontSourceFS, fsys: fsys, path: path}
+
+		return nil
+	})
+}
+
+// Get the font name from a font file name
+// ok is false if the file has no font extension (.flf or .tlf)
+func fontNameFromFile(fileName string) (name string, ok bool) {
+	for _, ext := range []string{extension, toiletExtension} {
+		if strings.HasSuffix(fileName, "."+ext) {
+			return strings.TrimSuffix(fileName, "."+ext), true
+		}
 	}
+	return "", false
+}
 
+// Save the found font files in the fontList
+// Already loaded fonts take precedence and are not replaced
+func (fm *fontManager) registerFontFiles(files map[string]fontFile) {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	for fontName, file := range files {
+		if _, ok := fm.fontLib[fontName]; ok {
+			continue
+		}
+		fm.fontList[fontName] = file
+	}
+}
+
+// Get information about all known fonts, sorted by name
+func (fm *fontManager) fonts() []FontInfo {
+	fm.mu.RLock()
+	defer fm.mu.RUnlock()
+
+	fonts := make([]FontInfo, 0, len(fm.fontList))
+	for name := range fm.fontList {
+		fonts = append(fonts, fm.fontInfo(name))
+	}
+	sort.Slice(fonts, func(i, j int) bool {
+		return fonts[i].Name < fonts[j].Name
+	})
+
+	return fonts
+}
+
+// Get information about a registered font
+// The caller must hold fm.mu
+func (fm *fontManager) fontInfo(fontName string) FontInfo {
+	file := fm.fontList[fontName]
+	info := FontInfo{
+		Name:   fontName,
+		Source: file.source,
+		Path:   file.path,
+	}
+	if font, ok := fm.fontLib[fontName]; ok {
+		info.Loaded = true
+		info.Height = font.Height
+	}
+	return info
+}
+
+// Read and parse a font file from disk or a filesystem
+func (file fontFile) load() (*Font, error) {
 	// Read file contents
-	fontStr, err := ioutil.ReadFile(path)
+	fontStr, err := file.read()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Parse the file contents
-	font, err := parseFontContent(string(fontStr))
-	if err != nil {
-		return err
+	return parseFontContent(string(fontStr))
+}
+
+// Maximum number of suggestions in ErrFontNotFound
+const maxSuggestions int = 3
+
+// Get the known font names which are close to the given name
+// by edit distance, closest first
+// The caller must hold fm.mu
+func (fm *fontManager) similarFontNames(fontName string) []string {
+	// Allow more typos in longer names
+	maxDist := len(fontName) / 3
+	if maxDist < 2 {
+		maxDist = 2
 	}
 
-	// Register the font object in the fontLib
-	fm.fontLib[fontName] = font
+	dists := make(map[string]int)
+	check := func(name string) {
+		dist := editDistance(strings.ToLower(fontName), strings.ToLower(name))
+		if dist <= maxDist {
+			dists[name] = dist
+		}
+	}
+	for name := range fm.fontLib {
+		check(name)
+	}
+	for name := range fm.fontList {
+		check(name)
+	}
 
-	return nil
+	names := make([]string, 0, len(dists))
+	for name := range dists {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if dists[names[i]] != dists[names[j]] {
+			return dists[names[i]] < dists[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	if len(names) > maxSuggestions {
+		names = names[:maxSuggestions]
+	}
+	return names
+}
+
+// Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// Only the previous row of the matrix is needed
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
 }
 
-// Load the builtin fonts from the bindata.go file
+// Read the contents of a font file
+func (file fontFile) read() ([]byte, error) {
+	if file.fsys == nil {
+		return os.ReadFile(file.path)
+	}
+	return fs.ReadFile(file.fsys, file.path)
+}
+
+// Load the builtin fonts embedded from the assets directory
 // Load all fonts specified on top (defaultFonts)
 func (fm *fontManager) loadBuildInFont() error {
 
 	// Load each default font
 	for _, name := range defaultFonts {
+		file := fontFile{
+			source: FontSourceBuiltin,
+			fsys:   builtinFonts,
+			path:   "assets/" + name + "." + extension,
+		}
 		// Get Contents
-		fontStr, err := Asset(name + "." + extension)
+		fontStr, err := file.read()
 		if err != nil {
 			return err
 		}
 		// Load the font
-		err = fm.loadBindataFont(fontStr, name)
+		err = fm.loadFont(fontStr, name, file)
 		if err != nil {
 			return err
 		}
@@ -134,6 +430,11 @@ func (fm *fontManager) loadBuildInFont() error {
 
 // Load a bindata font
 func (fm *fontManager) loadBindataFont(fontBinary []byte, fontName string) error {
+	return fm.loadFont(fontBinary, fontName, fontFile{source: FontSourceBindata})
+}
+
+// Parse a font and register it in the fontLib and fontList
+func (fm *fontManager) loadFont(fontBinary []byte, fontName string, file fontFile) error {
 
 	// Get the font
 	font, err := parseFontContent(string(fontBinary))
@@ -141,36 +442,53 @@ func (fm *fontManager) loadBindataFont(fontBinary []byte, fontName string) error
 		return err
 	}
 	// Register the font object in the fontLib
+	fm.mu.Lock()
 	fm.fontLib[fontName] = font
+	fm.fontList[fontName] = file
+	fm.mu.Unlock()
 
 	return nil
 }
 
 // Parse a font from a content string
 // Used to load fonts from disk and the builtin fonts
-func parseFontContent(cont string) (*font, error) {
-	// Get all lines
-	lines := strings.Split(cont, "\n")
-
-	if len(lines) < 1 {
-		return nil, errors.New("Font content error")
+func parseFontContent(cont string) (*Font, error) {
+	// Decompress zipped fonts first
+	if strings.HasPrefix(cont, zipSignature) {
+		var err error
+		cont, err = unzipFontContent(cont)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Get the header metadata
-	header := strings.Split(lines[0], " ")
+	return parseFont(cont)
+}
 
-	// Line end of the comment
-	commentEndLine, _ := strconv.Atoi(header[5])
+// Get the content of the first file in a zip compressed font
+func unzipFontContent(cont string) (string, error) {
+	r, err := zip.NewReader(strings.NewReader(cont), int64(len(cont)))
+	if err != nil {
+		return "", err
+	}
+	if len(r.File) < 1 {
+		return "", errors.New("Font content error: empty zip archive")
+	}
 
-	// Char height
-	height, _ := strconv.Atoi(header[1])
+	f, err := r.File[0].Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
 
-	// Initialize the font
-	font := &font{
-		hardblank: header[0][len(header[0])-1:],
-		height:    height,
-		fontSlice: lines[commentEndLine+1:],
+	// Zip bombs are cut off instead of read into memory
+	b, err := io.ReadAll(io.LimitReader(f, maxUnzippedSize+1))
+	if err != nil {
+		return "", err
+	}
+	if int64(len(b)) > maxUnzippedSize {
+		return "", errors.New("Font content error: decompressed font too large")
 	}
 
-	return font, nil
+	return string(b), nil
 }
@@ -0,0 +1,108 @@
+package figlet4go
+
+// A text laid out in a font, ready to be formatted by a parser
+type banner struct {
+	// The rendered text before control files were applied
+	text string
+	// Name of the used font, the default font after a fallback
+	fontName string
+	// Height of the font
+	height int
+	// Chars wrapped into rows
+	rows [][]asciiChar
+	// Escapes the glyphs of the lines, nil for no escaping
+	escape func(string) string
+}
+
+// A part of a line with a single color
+type segment struct {
+	text  string
+	color Color
+}
+
+// Banner is a text laid out in a font, passed to the format function
+// of a parser (see RegisterParser)
+type Banner struct {
+	// The rendered text before control files were applied
+	Text string
+	// Name of the used font, the default font after a fallback
+	FontName string
+	// Width of the widest line in display columns
+	Width int
+	// All lines of the output, every char is a segment of the line
+	// with its content escaped by the parser
+	Lines [][]Segment
+}
+
+// Segment is a part of a line with a single color, nil for no color
+type Segment struct {
+	Text  string
+	Color Color
+}
+
+// Width of the widest row in display columns
+func (b *banner) width() int {
+	width := 0
+	for _, chars := range b.rows {
+		rowWidth := 0
+		for _, char := range chars {
+			rowWidth += char.Width
+		}
+		width = max(width, rowWidth)
+	}
+	return width
+}
+
+// Get all lines of the banner, every char is a segment of the line
+// with its content escaped
+func (b *banner) lines() [][]segment {
+	lines := make([][]segment, 0, len(b.rows)*b.height)
+	for _, chars := range b.rows {
+		for curLine := 0; curLine < b.height; curLine++ {
+			line := make([]segment, len(chars))
+			for i, char := range chars {
+				text := char.Lines[curLine]
+				if b.escape != nil {
+					text = b.escape(text)
+				}
+				line[i] = segment{text, char.Color}
+			}
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// Get the exported banner for the format function of a registered parser
+func (b *banner) export() Banner {
+	lines := [][]Segment{}
+	for _, line := range b.lines() {
+		segs := make([]Segment, len(line))
+		for i, seg := range line {
+			segs[i] = Segment{seg.text, seg.color}
+		}
+		lines = append(lines, segs)
+	}
+	return Banner{b.text, b.fontName, b.width(), lines}
+}
+
+// Merge adjacent segments with the same key into one
+// The key is the formatted color, so colors which look the
+// same (f.e. ColorRed and its TrueColor) are merged too
+func mergeRuns(line []segment, key func(Color) string) []segment {
+	runs := []segment{}
+	lastKey := ""
+	for _, seg := range line {
+		k := ""
+		if seg.color != nil {
+			k = key(seg.color)
+		}
+		if len(runs) > 0 && k == lastKey {
+			runs[len(runs)-1].text += seg.text
+			continue
+		}
+		runs = append(runs, seg)
+		lastKey = k
+	}
+	return runs
+}
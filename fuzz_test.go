@@ -0,0 +1,127 @@
+package figlet4go
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Add the builtin fonts and a few broken headers to the corpus
+func addFontSeeds(f *testing.F) {
+	for _, name := range []string{"standard", "larry3d"} {
+		data, err := os.ReadFile(filepath.Join("assets", name+"."+extension))
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data, "Hello")
+	}
+	f.Add([]byte(testToiletFont()), "A ★")
+	f.Add([]byte("flf2a$ 1 1 1 0 0\n@@\n"), " ")
+	f.Add([]byte("flf2a"), "")
+	f.Add([]byte("tlf2a  2 1 4 -1 0\n @\n@@\n0x41\nA@\nA@@\n"), "A")
+	f.Add([]byte(zipSignature+"broken"), "x")
+}
+
+func FuzzParseFont(f *testing.F) {
+	addFontSeeds(f)
+
+	f.Fuzz(func(t *testing.T, data []byte, text string) {
+		font, err := ParseFont(strings.NewReader(string(data)))
+		if err != nil {
+			return
+		}
+		for _, code := range font.Chars() {
+			if lines, _ := font.Char(code); len(lines) != font.Height {
+				t.Fatalf("char %U has %d lines, height %d", code, len(lines), font.Height)
+			}
+		}
+
+		// Render with the parsed font
+		ascii := NewAsciiRender()
+		if err := ascii.LoadBindataFont(data, "fuzz"); err != nil {
+			t.Fatalf("parsed font doesn't load: %v", err)
+		}
+		opt := NewRenderOptions()
+		opt.FontName = "fuzz"
+		ascii.RenderOpts(text, opt)
+		opt.Width = 10
+		ascii.RenderOpts(text, opt)
+
+		// The written font must parse again
+		var b strings.Builder
+		if err := font.WriteFLF(&b); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseFont(strings.NewReader(b.String())); err != nil {
+			t.Fatalf("written font doesn't parse: %v", err)
+		}
+	})
+}
+
+func FuzzValidate(f *testing.F) {
+	addFontSeeds(f)
+
+	f.Fuzz(func(t *testing.T, data []byte, text string) {
+		if _, err := Validate(strings.NewReader(string(data))); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func FuzzRenderOpts(f *testing.F) {
+	for _, seed := range []string{"Hello, World!", "ÄÖÜäöüß", "中文 é", "\x00\xff\n\t", ""} {
+		f.Add(seed, "standard", 0, "ff0000;red")
+	}
+
+	ascii := NewAsciiRender()
+	f.Fuzz(func(t *testing.T, text, font string, width int, colors string) {
+		opt := NewRenderOptions()
+		opt.FontName = font
+		opt.Fallback = true
+		opt.Width = width
+		for _, c := range strings.Split(colors, ";") {
+			if color, err := NewTrueColorFromHexString(c); err == nil {
+				opt.FontColor = append(opt.FontColor, color)
+			}
+		}
+
+		render := func() {
+			if _, err := ascii.RenderOpts(text, opt); err != nil {
+				// Only chars missing in the font are an error
+				var notInFont *ErrCharNotInFont
+				if !errors.As(err, &notInFont) {
+					t.Fatal(err)
+				}
+			}
+		}
+		for _, name := range []string{"terminal", "html", "svg"} {
+			opt.Parser = getParser(t, name)
+			render()
+		}
+		for _, layout := range []Layout{LayoutFont, LayoutKerning, LayoutSmushing, LayoutOverlapping} {
+			opt.Layout = layout
+			render()
+		}
+		// Images take 8x16 pixels per column, keep them small
+		if len(text) < 100 {
+			ascii.RenderImage(text, opt)
+		}
+	})
+}
+
+func FuzzParseControl(f *testing.F) {
+	f.Add("# upper.flc\nt a-z A-Z\n", "Hello!")
+	f.Add("0x41 66\nt \\  _\nt \\0x21 ?\nf\nt b d\n", "a b!")
+	f.Add("g1 96 A\ngR 1\n", "x\xe9\x1b-B\xe9")
+	f.Add("g1 94 K\nu\nb\n", "a\x0eb\x0fc")
+
+	f.Fuzz(func(t *testing.T, control, text string) {
+		c, err := ParseControl(strings.NewReader(control))
+		if err != nil {
+			return
+		}
+		applyControls(text, []*Control{c})
+	})
+}
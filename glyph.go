@@ -0,0 +1,50 @@
+package figlet4go
+
+import "strings"
+
+// A single char of a font, decoded once when it is added to the font
+// Glyphs are shared by all renders and never modified
+type glyph struct {
+	// Lines as in the font file without endmarks, hardblanks are kept
+	raw []string
+	// Lines as rendered, hardblanks are replaced by spaces
+	rows []string
+	// Lines as runes with the hardblanks, used for kerning and smushing
+	cells [][]rune
+	// Width of all rows in display columns
+	width int
+	// Blank columns at the left and right end of each row, hardblanks
+	// are not blank (used for kerning and smushing)
+	left  []int
+	right []int
+}
+
+// Decode the lines of a char
+// The lines must be padded to the same width (see padCharLines)
+func newGlyph(lines []string, hardblank string) *glyph {
+	g := &glyph{
+		raw:   lines,
+		rows:  make([]string, len(lines)),
+		cells: make([][]rune, len(lines)),
+		left:  make([]int, len(lines)),
+		right: make([]int, len(lines)),
+	}
+	if len(lines) > 0 {
+		g.width = stringWidth(lines[0])
+	}
+
+	for i, line := range lines {
+		g.rows[i] = strings.Replace(line, hardblank, " ", -1)
+		g.cells[i] = []rune(line)
+
+		trimmed := strings.TrimLeft(line, " ")
+		g.left[i] = len(line) - len(trimmed)
+		if trimmed == "" {
+			g.right[i] = g.width
+			continue
+		}
+		g.right[i] = len(trimmed) - len(strings.TrimRight(trimmed, " "))
+	}
+
+	return g
+}
@@ -1,5 +1,3 @@
 module github.com/HoldenLucas/figlet4go
 
 go 1.25.5
-
-require github.com/mbndr/figlet4go v0.0.0-20190224160619-d6cef5b186ea
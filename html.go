@@ -0,0 +1,57 @@
+package figlet4go
+
+import (
+	"html"
+	"strings"
+)
+
+// Class of the <pre> block of the html-pre parser
+const htmlClass string = "figlet"
+
+// Class of a color in the html-pre parser, f.e. "figlet-ff4136"
+func htmlColorClass(c Color) string {
+	return htmlClass + "-" + colorHex(c)
+}
+
+// HTMLStylesheet returns the CSS rules for the color classes of the
+// html-pre parser, each color once in the given order
+func HTMLStylesheet(colors []Color) string {
+	var b strings.Builder
+	seen := make(map[string]bool)
+	for _, c := range colors {
+		class := htmlColorClass(c)
+		if seen[class] {
+			continue
+		}
+		seen[class] = true
+		b.WriteString("." + class + " { color: #" + colorHex(c) + "; }\n")
+	}
+	return b.String()
+}
+
+// Format a banner as <pre> block with one <span> per run of a color
+// The source text is the aria-label of the block
+// If inline is set the colors are set with style attributes instead of classes
+func formatHTMLPre(inline bool) func(b *banner) string {
+	return func(b *banner) string {
+		var out strings.Builder
+
+		out.WriteString(`<pre class="` + htmlClass + `" role="img" aria-label="` + html.EscapeString(b.text) + `">`)
+		for _, line := range b.lines() {
+			for _, run := range mergeRuns(line, colorHex) {
+				switch {
+				case run.color == nil:
+					out.WriteString(run.text)
+				case inline:
+					out.WriteString(`<span style="color: #` + colorHex(run.color) + `;">` + run.text + "</span>")
+				default:
+					out.WriteString(`<span class="` + htmlColorClass(run.color) + `">` + run.text + "</span>")
+				}
+			}
+			out.WriteString("\n")
+		}
+		out.WriteString("</pre>\n")
+
+		return out.String()
+	}
+}
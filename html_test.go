@@ -0,0 +1,64 @@
+package figlet4go
+
+import (
+	"strings"
+	"testing"
+)
+
+// Load a font named "art" whose chars contain markup characters
+// Every char is a single line "<c&>" with c the char itself,
+// '"' is drawn as `"'\` and ' ' as two spaces
+func loadArtFont(t *testing.T, ascii *AsciiRender) {
+	t.Helper()
+
+	font := NewFont(1)
+	for _, code := range requiredChars {
+		line := "<" + string(code) + "&>"
+		switch code {
+		case '"':
+			line = `"'\`
+		case ' ':
+			line = "  "
+		}
+		if err := font.SetChar(code, []string{line}, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var b strings.Builder
+	if err := font.WriteFLF(&b); err != nil {
+		t.Fatal(err)
+	}
+	if err := ascii.LoadBindataFont([]byte(b.String()), "art"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHTMLPre(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+
+	opt := NewRenderOptions()
+	opt.FontName = "art"
+	opt.Parser = getParser(t, "html-pre")
+	// Looks the same as ColorRed, so the runs are merged
+	opt.FontColor = []Color{ColorRed, TrueColor{255, 65, 54}, ColorBlue}
+
+	got, err := ascii.RenderOpts(`a"b c`, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<pre class="figlet" role="img" aria-label="a&#34;b c">` +
+		`<span class="figlet-ff4136">&lt;a&amp;&gt;&#34;&#39;\</span>` +
+		`<span class="figlet-0074d9">&lt;b&amp;&gt;</span>` +
+		`<span class="figlet-ff4136">  &lt;c&amp;&gt;</span>` +
+		"\n</pre>\n"
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	wantCSS := ".figlet-ff4136 { color: #ff4136; }\n.figlet-0074d9 { color: #0074d9; }\n"
+	if css := HTMLStylesheet(opt.FontColor); css != wantCSS {
+		t.Errorf("got stylesheet:\n%s\nwant:\n%s", css, wantCSS)
+	}
+}
@@ -0,0 +1,136 @@
+package figlet4go
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Size of a single column of the output in pixels
+const (
+	cellWidth  int = 8
+	cellHeight int = 16
+)
+
+// Colors of uncolored chars and the background
+var (
+	imageForeground color.RGBA = color.RGBA{0, 0, 0, 255}
+	imageBackground color.RGBA = color.RGBA{255, 255, 255, 255}
+)
+
+// RenderImage renders a string into an image
+// Every column of the output is a cell of 8x16 pixels, line chars
+// (f.e. '_', '/' or '|') are drawn as strokes, all others as blocks
+// The colors of opt are used, the parser is ignored
+func (ar *AsciiRender) RenderImage(str string, opt *RenderOptions) (image.Image, error) {
+	b, err := ar.layout(str, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, max(b.width(), 1)*cellWidth, len(b.rows)*b.height*cellHeight))
+	draw.Draw(img, img.Bounds(), image.NewUniform(imageBackground), image.Point{}, draw.Src)
+
+	for r, chars := range b.rows {
+		for curLine := 0; curLine < b.height; curLine++ {
+			y := (r*b.height + curLine) * cellHeight
+			x := 0
+
+			for _, char := range chars {
+				fg := imageForeground
+				if char.Color != nil {
+					fg = colorRGBA(char.Color)
+				}
+
+				for _, cr := range char.Lines[curLine] {
+					drawCell(img, x, y, cr, fg)
+					x += runeWidth(cr) * cellWidth
+				}
+			}
+		}
+	}
+
+	return img, nil
+}
+
+// RenderSVG renders a string into a standalone SVG document
+// The lines are created by the svg parser, the parser of opt is ignored
+func (ar *AsciiRender) RenderSVG(str string, opt *RenderOptions) (string, error) {
+	p, err := GetParser("svg")
+	if err != nil {
+		return "", err
+	}
+	svgOpt := *opt
+	svgOpt.Parser = *p
+
+	b, err := ar.layout(str, &svgOpt)
+	if err != nil {
+		return "", err
+	}
+	lines := formatBanner(b, &svgOpt)
+
+	// The lines are indented by 10 pixels by the svg parser,
+	// monospace fonts are about 0.6em wide
+	width := 20 + max(b.width(), 1)*cellHeight*3/5
+	height := 20 + len(b.rows)*b.height*cellHeight
+
+	return fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\">"+
+		"<text y=\"10\" font-family=\"monospace\" font-size=\"%dpx\" xml:space=\"preserve\">%s</text></svg>\n",
+		width, height, cellHeight, lines), nil
+}
+
+// Get the RGB value of a color
+func colorRGBA(c Color) color.RGBA {
+	switch c := c.(type) {
+	case TrueColor:
+		return color.RGBA{uint8(c.r), uint8(c.g), uint8(c.b), 255}
+	case *TrueColor:
+		return color.RGBA{uint8(c.r), uint8(c.g), uint8(c.b), 255}
+	case AnsiColor:
+		return colorRGBA(tcfac[c])
+	}
+	return imageForeground
+}
+
+// Draw a single char into the cell at x, y
+func drawCell(img *image.RGBA, x, y int, char rune, fg color.RGBA) {
+	w := runeWidth(char) * cellWidth
+	h := cellHeight
+
+	// Fill a rectangle relative to the cell
+	fill := func(x0, y0, x1, y1 int) {
+		draw.Draw(img, image.Rect(x+x0, y+y0, x+x1, y+y1), image.NewUniform(fg), image.Point{}, draw.Src)
+	}
+
+	switch char {
+	case ' ':
+	case '_':
+		fill(0, h-2, w, h)
+	case '-', '~':
+		fill(0, h/2-1, w, h/2+1)
+	case '=':
+		fill(0, h/2-4, w, h/2-2)
+		fill(0, h/2+2, w, h/2+4)
+	case '|', '!', '(', ')', '[', ']', '{', '}':
+		fill(w/2-1, 0, w/2+1, h)
+	case '.', ',':
+		fill(w/2-1, h-4, w/2+1, h-2)
+	case '\'', '`':
+		fill(w/2-1, 0, w/2+1, 4)
+	case ':', ';':
+		fill(w/2-1, h/2-4, w/2+1, h/2-2)
+		fill(w/2-1, h-4, w/2+1, h-2)
+	case '/', '\\':
+		// Diagonal through the cell, one 2 pixel wide step per row
+		for row := 0; row < h; row++ {
+			col := row * w / h
+			if char == '/' {
+				col = w - 1 - col
+			}
+			fill(max(col-1, 0), row, min(col+1, w), row+1)
+		}
+	default:
+		fill(0, 0, w, h)
+	}
+}
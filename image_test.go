@@ -0,0 +1,63 @@
+package figlet4go
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+)
+
+func TestRenderImage(t *testing.T) {
+	ascii := NewAsciiRender()
+	opt := NewRenderOptions()
+	opt.FontColor = []Color{ColorRed}
+
+	text, err := ascii.RenderOpts("I", NewRenderOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+
+	img, err := ascii.RenderImage("I", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := img.Bounds().Size()
+	if size.X != len(lines[0])*cellWidth || size.Y != len(lines)*cellHeight {
+		t.Fatalf("unexpected size %v for %d lines of %d columns", size, len(lines), len(lines[0]))
+	}
+
+	// Every column of the text is a cell, spaces are empty
+	red := colorRGBA(ColorRed)
+	for y, line := range lines {
+		for x, char := range line {
+			found := false
+			for py := y * cellHeight; py < (y+1)*cellHeight; py++ {
+				for px := x * cellWidth; px < (x+1)*cellWidth; px++ {
+					if img.At(px, py) == color.Color(red) {
+						found = true
+					}
+				}
+			}
+			if found != (char != ' ') {
+				t.Errorf("cell %d,%d of %q: drawn %t", x, y, char, found)
+			}
+		}
+	}
+}
+
+func TestRenderSVG(t *testing.T) {
+	ascii := NewAsciiRender()
+	opt := NewRenderOptions()
+	opt.FontColor = []Color{TrueColor{1, 2, 3}}
+
+	svg, err := ascii.RenderSVG("I", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(svg, "<svg xmlns=\"http://www.w3.org/2000/svg\"") || !strings.HasSuffix(svg, "</svg>\n") {
+		t.Errorf("not a svg document: %s", svg)
+	}
+	if !strings.Contains(svg, "<tspan fill='rgb(1,2,3)'>") {
+		t.Errorf("missing color: %s", svg)
+	}
+}
@@ -0,0 +1,68 @@
+package figlet4go
+
+import (
+	"fmt"
+	"strings"
+)
+
+// mIRC color codes
+const (
+	ircBold  string = "\x02"
+	ircColor string = "\x03"
+	// Resets all formatting, unlike a single color code
+	// it can't be mistaken for a code by a following digit
+	ircReset string = "\x0f"
+)
+
+// The 16 standard mIRC colors by their code
+var ircPalette []TrueColor = []TrueColor{
+	{255, 255, 255}, // white
+	{0, 0, 0},       // black
+	{0, 0, 127},     // blue
+	{0, 147, 0},     // green
+	{255, 0, 0},     // light red
+	{127, 0, 0},     // brown
+	{156, 0, 156},   // purple
+	{252, 127, 0},   // orange
+	{255, 255, 0},   // yellow
+	{0, 252, 0},     // light green
+	{0, 147, 147},   // cyan
+	{0, 255, 255},   // light cyan
+	{0, 0, 252},     // light blue
+	{255, 0, 255},   // pink
+	{127, 127, 127}, // grey
+	{210, 210, 210}, // light grey
+}
+
+// Get the mIRC code of a color, the nearest of the palette
+// AnsiColors are matched by their TrueColor lookalikes (see tcfac)
+func ircColorCode(c Color) int {
+	rgba := colorRGBA(c)
+	return nearestColor(TrueColor{int(rgba.R), int(rgba.G), int(rgba.B)}, ircPalette)
+}
+
+// Format a banner with mIRC color codes, one line for each message
+// Runs of the same color share a code to keep the messages short
+func formatIRC(b *banner) string {
+	var out strings.Builder
+
+	for _, line := range b.lines() {
+		for _, run := range mergeRuns(line, func(c Color) string { return fmt.Sprint(ircColorCode(c)) }) {
+			text := run.text
+			if run.color == nil {
+				out.WriteString(text)
+				continue
+			}
+
+			out.WriteString(fmt.Sprintf("%s%02d", ircColor, ircColorCode(run.color)))
+			// A comma followed by a digit would be read as background color
+			if strings.HasPrefix(text, ",") {
+				out.WriteString(ircBold + ircBold)
+			}
+			out.WriteString(text + ircReset)
+		}
+		out.WriteString("\n")
+	}
+
+	return out.String()
+}
@@ -0,0 +1,93 @@
+package figlet4go
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNearestColor(t *testing.T) {
+	tests := []struct {
+		color TrueColor
+		want  int
+	}{
+		{TrueColor{250, 250, 250}, 0},
+		{TrueColor{10, 5, 0}, 1},
+		{TrueColor{240, 20, 30}, 4},
+		{TrueColor{128, 128, 120}, 14},
+	}
+	for _, test := range tests {
+		if got := nearestColor(test.color, ircPalette); got != test.want {
+			t.Errorf("%v: got %d, want %d", test.color, got, test.want)
+		}
+	}
+}
+
+func TestIRCColorCode(t *testing.T) {
+	tests := []struct {
+		color Color
+		want  int
+	}{
+		// AnsiColors are matched by their lookalikes
+		{ColorBlack, 1},
+		{ColorRed, 4},
+		{ColorGreen, 3},
+		{ColorYellow, 8},
+		{ColorBlue, 2},
+		{ColorMagenta, 6},
+		{ColorCyan, 10},
+		{ColorWhite, 0},
+		{TrueColor{0, 150, 10}, 3},
+		{&TrueColor{250, 120, 10}, 7},
+	}
+	for _, test := range tests {
+		if got := ircColorCode(test.color); got != test.want {
+			t.Errorf("%v: got %d, want %d", test.color, got, test.want)
+		}
+	}
+}
+
+func TestIRC(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+
+	// a and b have the same code and are merged
+	opt := NewRenderOptions()
+	opt.FontName = "art"
+	opt.Parser = getParser(t, "irc")
+	opt.FontColor = []Color{ColorRed, TrueColor{240, 20, 30}, ColorBlack}
+	got, err := ascii.RenderOpts("abc", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "\x0304<a&><b&>\x0f\x0301<c&>\x0f\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// A comma at the start of a run is separated from the color code
+	font := NewFont(1)
+	font.SetChar('x', []string{",5"}, "")
+	var b strings.Builder
+	font.WriteFLF(&b)
+	if err := ascii.LoadBindataFont([]byte(b.String()), "comma"); err != nil {
+		t.Fatal(err)
+	}
+	opt.FontName = "comma"
+	opt.FontColor = []Color{ColorRed}
+	got, err = ascii.RenderOpts("x", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "\x0304\x02\x02,5\x0f\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestChat(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+
+	got := renderArt(t, ascii, "``", "chat", []Color{ColorRed})
+	if want := "```\n<`​&><`​&>\n```\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
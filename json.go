@@ -0,0 +1,102 @@
+package figlet4go
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// A banner as JSON object
+type jsonBanner struct {
+	// Name of the used font
+	Font string `json:"font"`
+	// The rendered text
+	Text string `json:"text"`
+	// Width in display columns
+	Width int `json:"width"`
+	// Number of lines
+	Height int `json:"height"`
+	// Cells or runs of each line
+	Rows any `json:"rows"`
+}
+
+// A single char of the output, wide chars take two columns
+// and combining chars are part of the preceding cell
+type jsonCell struct {
+	Char string `json:"char"`
+	// Colors as "#rrggbb", null for the default color
+	Fg *string `json:"fg"`
+	Bg *string `json:"bg"`
+}
+
+// A run of chars with the same colors
+type jsonRun struct {
+	Text string  `json:"text"`
+	Fg   *string `json:"fg"`
+	Bg   *string `json:"bg"`
+}
+
+// Get a color as "#rrggbb", nil for no color
+func jsonColor(c Color) *string {
+	if c == nil {
+		return nil
+	}
+	hex := "#" + colorHex(c)
+	return &hex
+}
+
+// Format a banner as JSON object with a cell for every char
+// Backgrounds aren't supported yet, bg is always null
+func formatJSON(b *banner) string {
+	rows := [][]jsonCell{}
+	for _, line := range b.lines() {
+		cells := []jsonCell{}
+		for _, seg := range line {
+			fg := jsonColor(seg.color)
+			for _, r := range seg.text {
+				// Combining chars are added to the preceding char
+				if len(cells) > 0 && isZeroWidth(r) {
+					cells[len(cells)-1].Char += string(r)
+					continue
+				}
+				cells = append(cells, jsonCell{Char: string(r), Fg: fg})
+			}
+		}
+		rows = append(rows, cells)
+	}
+
+	return encodeJSONBanner(b, rows)
+}
+
+// Format a banner as JSON object with a run for each part of
+// a line with the same color
+func formatJSONRuns(b *banner) string {
+	rows := [][]jsonRun{}
+	for _, line := range b.lines() {
+		runs := []jsonRun{}
+		for _, run := range mergeRuns(line, colorHex) {
+			runs = append(runs, jsonRun{Text: run.text, Fg: jsonColor(run.color)})
+		}
+		rows = append(rows, runs)
+	}
+
+	return encodeJSONBanner(b, rows)
+}
+
+// Encode the banner with its rows as a single line
+func encodeJSONBanner(b *banner, rows any) string {
+	var out strings.Builder
+	enc := json.NewEncoder(&out)
+	// Keep <, > and & of the glyphs readable
+	enc.SetEscapeHTML(false)
+
+	banner := jsonBanner{
+		Font:   b.fontName,
+		Text:   b.text,
+		Width:  b.width(),
+		Height: len(b.rows) * b.height,
+		Rows:   rows,
+	}
+	// Encoding strings and numbers can't fail
+	enc.Encode(banner)
+	return out.String()
+}
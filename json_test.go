@@ -0,0 +1,74 @@
+package figlet4go
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestJSON(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+
+	got := renderArt(t, ascii, `a"`, "json", []Color{ColorRed})
+	red := "#ff4136"
+	var decoded struct {
+		Font   string
+		Text   string
+		Width  int
+		Height int
+		Rows   [][]jsonCell
+	}
+	if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("invalid json: %v\n%s", err, got)
+	}
+
+	if decoded.Font != "art" || decoded.Text != `a"` || decoded.Width != 7 || decoded.Height != 1 {
+		t.Errorf("unexpected header in %s", got)
+	}
+	want := [][]jsonCell{{
+		{"<", &red, nil}, {"a", &red, nil}, {"&", &red, nil}, {">", &red, nil},
+		{`"`, &red, nil}, {"'", &red, nil}, {`\`, &red, nil},
+	}}
+	if !reflect.DeepEqual(decoded.Rows, want) {
+		t.Errorf("unexpected rows in %s", got)
+	}
+}
+
+func TestJSONCells(t *testing.T) {
+	ascii := NewAsciiRender()
+	fsys := fstest.MapFS{"block.tlf": {Data: []byte(testToiletFont())}}
+	if err := ascii.LoadFontFS(fsys, "."); err != nil {
+		t.Fatal(err)
+	}
+
+	opt := NewRenderOptions()
+	opt.FontName = "block"
+	opt.Parser = getParser(t, "json")
+	got, err := ascii.RenderOpts("中", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The wide char is a single cell, the combining accents
+	// are part of the preceding cells
+	want := `{"font":"block","text":"中","width":3,"height":2,"rows":[` +
+		`[{"char":"中","fg":null,"bg":null},{"char":" ","fg":null,"bg":null}],` +
+		`[{"char":"ё","fg":null,"bg":null},{"char":"e` + "\u0301" + `","fg":null,"bg":null},{"char":"e` + "\u0301" + `","fg":null,"bg":null}]]}` + "\n"
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestJSONRuns(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+
+	got := renderArt(t, ascii, "abc", "json-runs", []Color{ColorRed, ColorRed, ColorBlue})
+	want := `{"font":"art","text":"abc","width":12,"height":1,"rows":[[` +
+		`{"text":"<a&><b&>","fg":"#ff4136","bg":null},{"text":"<c&>","fg":"#0074d9","bg":null}]]}` + "\n"
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,36 @@
+package figlet4go
+
+import "strings"
+
+// Format a banner as fenced code block, colors are ignored
+// The fence is longer than any run of backticks in the glyphs
+func formatMarkdown(b *banner) string {
+	lines := make([]string, 0, len(b.rows)*b.height)
+	for _, line := range b.lines() {
+		var text strings.Builder
+		for _, seg := range line {
+			text.WriteString(seg.text)
+		}
+		lines = append(lines, text.String())
+	}
+
+	fence := strings.Repeat("`", max(3, longestRun(lines, '`')+1))
+	return fence + "\n" + strings.Join(append(lines, fence), "\n") + "\n"
+}
+
+// Get the length of the longest run of a char in the lines
+func longestRun(lines []string, char rune) int {
+	longest := 0
+	for _, line := range lines {
+		run := 0
+		for _, r := range line {
+			if r != char {
+				run = 0
+				continue
+			}
+			run++
+			longest = max(longest, run)
+		}
+	}
+	return longest
+}
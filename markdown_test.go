@@ -0,0 +1,47 @@
+package figlet4go
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdown(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+
+	if got, want := renderArt(t, ascii, "a`", "markdown", []Color{ColorRed}), "```\n<a&><`&>\n```\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// The fence is longer than the backticks of the glyphs
+	font := NewFont(2)
+	font.SetChar('a', []string{"````", "`"}, "")
+	var b strings.Builder
+	font.WriteFLF(&b)
+	if err := ascii.LoadBindataFont([]byte(b.String()), "ticks"); err != nil {
+		t.Fatal(err)
+	}
+	opt := NewRenderOptions()
+	opt.FontName = "ticks"
+	opt.Parser = getParser(t, "markdown")
+	got, err := ascii.RenderOpts("a", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "`````\n````\n`   \n`````\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestMarkdownHTML(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+
+	got := renderArt(t, ascii, "ab", "markdown-html", []Color{ColorRed, TrueColor{0, 0, 255}})
+	want := `<pre class="figlet" role="img" aria-label="ab">` +
+		`<span style="color: #ff4136;">&lt;a&amp;&gt;</span><span style="color: #0000ff;">&lt;b&amp;&gt;</span>` +
+		"\n</pre>\n"
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -1,6 +1,12 @@
 package figlet4go
 
-import "errors"
+import (
+	"errors"
+	"html"
+	"strings"
+	"sync"
+	"unicode"
+)
 
 // Parser stores some output specific stuff
 type Parser struct {
@@ -16,20 +22,114 @@ type Parser struct {
 	Replaces map[string]string
 }
 
+// Functions of a parser, looked up by its name like the colors
+type parserFuncs struct {
+	// Escapes the content of the glyphs (not the color codes)
+	// before the replaces, nil for no escaping
+	escape func(string) string
+	// Formats the whole banner instead of the pre- and suffixes, nil to use them
+	format func(b *banner) string
+	// Number of the registration, part of the cache key
+	version int
+}
+
+// Guards the parsers, they can be registered at any time
+var parsersMu sync.RWMutex
+
 var parsers map[string]Parser = map[string]Parser{
 	// Default terminal parser
 	"terminal": {"terminal", "", "", "", "\n", nil},
 	// Parser for HTML code
 	"html": {"html", "<code>", "</code>", "", "<br>", map[string]string{" ": "&nbsp;"}},
+	// Parser for a HTML <pre> block with CSS classes (see HTMLStylesheet)
+	"html-pre": {"html-pre", "", "", "", "", nil},
+	// Parser for a Markdown code block
+	"markdown": {"markdown", "", "", "", "", nil},
+	// Parser for Markdown with inline HTML, a <pre> block with colors in style attributes
+	"markdown-html": {"markdown-html", "", "", "", "", nil},
+	// Parser for a JSON object with a cell for every char
+	"json": {"json", "", "", "", "", nil},
+	// Parser for a JSON object with runs of chars with the same color
+	"json-runs": {"json-runs", "", "", "", "", nil},
+	// Parser for IRC with mIRC color codes
+	"irc": {"irc", "", "", "", "", nil},
+	// Parser for a code block of chat platforms (f.e. Slack), without colors
+	"chat": {"chat", "```\n", "```\n", "", "\n", nil},
 	// Parser for SVG
 	"svg": {"svg", "", "", "<tspan x=\"10\" dy=\"1em\">", "</tspan>", map[string]string{" ": "&#160;"}},
 }
 
+// Functions of the parsers by name, the html escaping is valid XML for svg
+var parserFuncsByName map[string]parserFuncs = map[string]parserFuncs{
+	"terminal":      {escape: stripControls},
+	"html":          {escape: html.EscapeString},
+	"html-pre":      {escape: html.EscapeString, format: formatHTMLPre(false)},
+	"markdown":      {escape: stripControls, format: formatMarkdown},
+	"markdown-html": {escape: html.EscapeString, format: formatHTMLPre(true)},
+	"json":          {format: formatJSON},
+	"json-runs":     {format: formatJSONRuns},
+	"irc":           {escape: stripControls, format: formatIRC},
+	"chat":          {escape: escapeChat},
+	"svg":           {escape: html.EscapeString},
+}
+
+// Number of the last registration
+var parserVersion int
+
 // GetParser returns a parser by its key
 func GetParser(key string) (*Parser, error) {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+
 	parser, ok := parsers[key]
 	if !ok {
 		return nil, errors.New("Invalid parser key: " + key)
 	}
 	return &parser, nil
 }
+
+// RegisterParser adds a parser which can be got by GetParser with its name,
+// a parser with the same name is replaced
+// escape is applied to the content of the glyphs (not the color codes), nil
+// to keep it. format formats the whole banner instead of the pre- and suffixes,
+// replaces and color codes, nil to use them. Color codes are only added
+// for the builtin names (see color.go), format can add its own
+func RegisterParser(p Parser, escape func(string) string, format func(b Banner) string) {
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+
+	parserVersion++
+	funcs := parserFuncs{escape: escape, version: parserVersion}
+	if format != nil {
+		funcs.format = func(b *banner) string {
+			return format(b.export())
+		}
+	}
+	parsers[p.Name] = p
+	parserFuncsByName[p.Name] = funcs
+}
+
+// Get the functions of a parser, none if the name isn't registered
+func getParserFuncs(p Parser) parserFuncs {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+
+	return parserFuncsByName[p.Name]
+}
+
+// Remove control chars and separate backticks by zero width spaces
+// so the glyphs can't end the code block of a chat message
+func escapeChat(str string) string {
+	return strings.ReplaceAll(stripControls(str), "`", "`\u200b")
+}
+
+// Remove control chars (f.e. escape sequences) from fonts,
+// they would be interpreted by the terminal or break the output
+func stripControls(str string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, str)
+}
@@ -0,0 +1,154 @@
+package figlet4go
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+// Get a registered parser
+func getParser(t testing.TB, name string) Parser {
+	t.Helper()
+
+	p, err := GetParser(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *p
+}
+
+// Render with the art font and a builtin parser
+func renderArt(t *testing.T, ascii *AsciiRender, text, parser string, colors []Color) string {
+	t.Helper()
+
+	opt := NewRenderOptions()
+	opt.FontName = "art"
+	opt.Parser = getParser(t, parser)
+	opt.FontColor = colors
+	out, err := ascii.RenderOpts(text, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestParserEscape(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+
+	tests := []struct {
+		parser string
+		colors []Color
+		want   string
+	}{
+		{"terminal", nil, "<a&>\"'\\\n"},
+		{"html", nil, "<code>&lt;a&amp;&gt;&#34;&#39;\\<br></code>"},
+		// Color markup isn't escaped
+		{"html", []Color{TrueColor{1, 2, 3}}, "<code><span style='color: rgb(1,2,3);'>&lt;a&amp;&gt;</span>" +
+			"<span style='color: rgb(1,2,3);'>&#34;&#39;\\</span><br></code>"},
+		{"svg", nil, "<tspan x=\"10\" dy=\"1em\">&lt;a&amp;&gt;&#34;&#39;\\</tspan>"},
+	}
+
+	for _, test := range tests {
+		if got := renderArt(t, ascii, `a"`, test.parser, test.colors); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.parser, got, test.want)
+		}
+	}
+}
+
+func TestParserEscapeSVGWellFormed(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+
+	opt := NewRenderOptions()
+	opt.FontName = "art"
+	opt.FontColor = []Color{ColorRed}
+	svg, err := ascii.RenderSVG(`a"b c`, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The text of the document is the art
+	text := ""
+	dec := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("invalid svg: %v\n%s", err, svg)
+		}
+		if data, ok := tok.(xml.CharData); ok {
+			text += string(data)
+		}
+	}
+	if want := "<a&>\"'\\<b&>\u00a0\u00a0<c&>"; strings.TrimSpace(text) != want {
+		t.Errorf("got text %q, want %q", text, want)
+	}
+}
+
+func TestParserEscapeTerminal(t *testing.T) {
+	ascii := NewAsciiRender()
+
+	// A font which tries to set the terminal title
+	font := NewFont(1)
+	font.SetChar('a', []string{"\x1b]0;pwned\x07x"}, "")
+	var b strings.Builder
+	font.WriteFLF(&b)
+	if err := ascii.LoadBindataFont([]byte(b.String()), "evil"); err != nil {
+		t.Fatal(err)
+	}
+
+	opt := NewRenderOptions()
+	opt.FontName = "evil"
+	got, err := ascii.RenderOpts("a", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "]0;pwnedx\n" {
+		t.Errorf("control chars not removed: %q", got)
+	}
+}
+
+func TestRegisterParser(t *testing.T) {
+	ascii := NewAsciiRender()
+	loadArtFont(t, ascii)
+	ascii.EnableCache(10, 0)
+	t.Cleanup(func() {
+		parsersMu.Lock()
+		defer parsersMu.Unlock()
+		delete(parsers, "upper")
+		delete(parserFuncsByName, "upper")
+	})
+
+	// A parser with pre- and suffixes
+	RegisterParser(Parser{"upper", "[", "]", "", "\n", nil}, strings.ToUpper, nil)
+	p, err := GetParser("upper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := NewRenderOptions()
+	opt.FontName = "art"
+	opt.Parser = *p
+	// Color codes are only added by the builtin parsers
+	opt.FontColor = []Color{ColorRed}
+	if got, _ := ascii.RenderOpts("ab", opt); got != "[<A&><B&>\n]" {
+		t.Errorf("got %q", got)
+	}
+
+	// Registering it again replaces the cached results
+	RegisterParser(*p, strings.ToUpper, func(b Banner) string {
+		out := b.FontName + ":"
+		for _, line := range b.Lines {
+			for _, seg := range line {
+				out += seg.Text + colorHex(seg.Color) + ";"
+			}
+		}
+		return out
+	})
+	if got, _ := ascii.RenderOpts("ab", opt); got != "art:<A&>ff4136;<B&>ff4136;" {
+		t.Errorf("got %q", got)
+	}
+}
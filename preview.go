@@ -0,0 +1,47 @@
+package figlet4go
+
+// FontPreview holds a sample rendered in a single font
+type FontPreview struct {
+	// The previewed font
+	Font FontInfo
+	// Height of the font, 0 if it couldn't be loaded
+	Height int
+	// The rendered sample
+	Output string
+	// Error if the font couldn't be loaded or the sample rendered
+	Err error
+}
+
+// Preview renders a sample string in every known font (like showfigfonts)
+// If str is empty the name of each font is rendered
+// Fonts which fail to load don't abort the preview, the error
+// is stored in the FontPreview instead
+func (ar *AsciiRender) Preview(str string, opt *RenderOptions) []FontPreview {
+	fonts := ar.Fonts()
+	previews := make([]FontPreview, len(fonts))
+
+	for i, info := range fonts {
+		previews[i].Font = info
+
+		font, err := ar.fontMgr.getFont(info.Name)
+		if err != nil {
+			previews[i].Err = err
+			continue
+		}
+		previews[i].Font.Loaded = true
+		previews[i].Height = font.Height
+
+		// Render with a copy of the options so the caller's are not modified
+		fontOpt := *opt
+		fontOpt.FontName = info.Name
+		fontOpt.Fallback = false
+
+		sample := str
+		if sample == "" {
+			sample = info.Name
+		}
+		previews[i].Output, previews[i].Err = ar.RenderOpts(sample, &fontOpt)
+	}
+
+	return previews
+}
@@ -1,5 +1,11 @@
 package figlet4go
 
+import (
+	"io/fs"
+	"strings"
+	"sync/atomic"
+)
+
 // RenderOptions are used to set color or maybe future
 // options to the AsciiRenderer
 type RenderOptions struct {
@@ -9,6 +15,16 @@ type RenderOptions struct {
 	FontColor []Color
 	// Parser
 	Parser Parser
+	// Use the default font instead of returning
+	// an error if the font can't be found or loaded
+	Fallback bool
+	// Control files translating the input, applied in order
+	Controls []*Control
+	// Maximum width of the output in columns, longer text is wrapped
+	// at spaces into several banners, 0 for no limit
+	Width int
+	// How the chars are fitted together, full width by default
+	Layout Layout
 }
 
 // NewRenderOptions creates new RenderOptions
@@ -25,6 +41,8 @@ func NewRenderOptions() *RenderOptions {
 type AsciiRender struct {
 	// FontManager to store all the fonts
 	fontMgr *fontManager
+	// Cache of the render results, nil if disabled
+	cache atomic.Pointer[renderCache]
 }
 
 // NewAsciiRender creates a new AsciiRender
@@ -34,16 +52,76 @@ func NewAsciiRender() *AsciiRender {
 	}
 }
 
-// LoadFont loads all *.flf font files recursively in a path
+// LoadFont loads all *.flf and *.tlf font files recursively in a path
 func (ar *AsciiRender) LoadFont(fontPath string) error {
+	defer ar.clearCache()
 	return ar.fontMgr.loadFontList(fontPath)
 }
 
+// LoadFontFS loads all *.flf and *.tlf font files recursively in root of the given filesystem
+// Works with embed.FS, zip archives (zip.Reader) or any other fs.FS
+func (ar *AsciiRender) LoadFontFS(fsys fs.FS, root string) error {
+	defer ar.clearCache()
+	return ar.fontMgr.loadFontListFS(fsys, root)
+}
+
 // LoadBinDataFont loads provided font binary
 func (ar *AsciiRender) LoadBindataFont(fontBinary []byte, fontName string) error {
+	defer ar.clearCache()
 	return ar.fontMgr.loadBindataFont(fontBinary, fontName)
 }
 
+// EnableCache caches the results of RenderOpts, the least recently used
+// results are evicted if more than maxEntries results or more than maxBytes
+// bytes are cached. A limit of 0 means no limit, if both are 0 the cache
+// is disabled. Replaces the previous cache and its counters
+// Results depend on the text, the font and the options,
+// loading fonts clears the cache
+func (ar *AsciiRender) EnableCache(maxEntries, maxBytes int) {
+	if maxEntries <= 0 && maxBytes <= 0 {
+		ar.cache.Store(nil)
+		return
+	}
+	ar.cache.Store(newRenderCache(maxEntries, maxBytes))
+}
+
+// CacheStats returns the counters of the render cache,
+// all are 0 if the cache is disabled
+func (ar *AsciiRender) CacheStats() CacheStats {
+	if cache := ar.cache.Load(); cache != nil {
+		return cache.statistics()
+	}
+	return CacheStats{}
+}
+
+// Remove all cached results, fonts may have changed
+func (ar *AsciiRender) clearCache() {
+	if cache := ar.cache.Load(); cache != nil {
+		cache.clear()
+	}
+}
+
+// Fonts returns all fonts the AsciiRender knows about, sorted by name
+// Fonts found by LoadFont or LoadFontFS are only loaded when used
+func (ar *AsciiRender) Fonts() []FontInfo {
+	return ar.fontMgr.fonts()
+}
+
+// FontInfo loads a font and returns information about it
+func (ar *AsciiRender) FontInfo(fontName string) (FontInfo, error) {
+	if fontName == "" {
+		fontName = defaultFont
+	}
+	if _, err := ar.fontMgr.getFont(fontName); err != nil {
+		return FontInfo{}, err
+	}
+
+	ar.fontMgr.mu.RLock()
+	defer ar.fontMgr.mu.RUnlock()
+
+	return ar.fontMgr.fontInfo(fontName), nil
+}
+
 // Render renders a string with the default options
 // Calls the RenderOpts method with a new RenderOptions object
 func (ar *AsciiRender) Render(str string) (string, error) {
@@ -54,24 +132,116 @@ func (ar *AsciiRender) Render(str string) (string, error) {
 // Can be called from the user (if options wished) or the above Render method
 // Contains the whole rendering logic
 func (ar *AsciiRender) RenderOpts(str string, opt *RenderOptions) (string, error) {
+	cache := ar.cache.Load()
+	if cache == nil {
+		return ar.render(str, opt)
+	}
+
+	key := cacheKey(str, opt)
+	result, generation, ok := cache.get(key)
+	if ok {
+		return result, nil
+	}
+	result, err := ar.render(str, opt)
+	if err != nil {
+		return "", err
+	}
+	cache.add(key, result, generation)
+	return result, nil
+}
+
+// Render a string without the cache
+func (ar *AsciiRender) render(str string, opt *RenderOptions) (string, error) {
+	b, err := ar.layout(str, opt)
+	if err != nil {
+		return "", err
+	}
+	return formatBanner(b, opt), nil
+}
+
+// Format a laid out banner with the parser and colors of opt
+func formatBanner(b *banner, opt *RenderOptions) string {
+	// Parsers formatting the whole banner
+	funcs := getParserFuncs(opt.Parser)
+	if funcs.format != nil {
+		b.escape = funcs.escape
+		return funcs.format(b)
+	}
+
+	// Result which will be returned, built in place
+	// because appending to a string is quadratic for long texts
+	var result strings.Builder
+
+	// Parser specific replaces and color codes are the same for all chars
+	replacer := newReplacer(opt.Parser)
+	codes := colorCodes(opt.FontColor, opt.Parser)
+
+	result.WriteString(opt.Parser.Prefix)
+
+	// Foreach wrapped row and line of the font height
+	for _, chars := range b.rows {
+		for curLine := 0; curLine < b.height; curLine++ {
+			result.WriteString(opt.Parser.LinePrefix)
+
+			// Add the current line of the char to the result
+			for i := range chars {
+				chars[i].writeLine(&result, curLine, funcs.escape, replacer, codes)
+			}
+
+			result.WriteString(opt.Parser.LineSuffix)
+		}
+	}
+
+	result.WriteString(opt.Parser.Suffix)
+
+	return result.String()
+}
+
+// Load the font and create the colored ascii chars of a string
+// The chars are wrapped into rows if opt.Width is set
+func (ar *AsciiRender) layout(str string, opt *RenderOptions) (*banner, error) {
 	// Should the text be colored
 	colored := len(opt.FontColor) > 0
 
 	// Load the font
-	font := ar.fontMgr.getFont(opt.FontName)
+	fontName := opt.FontName
+	if fontName == "" {
+		fontName = defaultFont
+	}
+	font, err := ar.fontMgr.getFont(fontName)
+	if err != nil {
+		if !opt.Fallback {
+			return nil, err
+		}
+		// Use the default font instead
+		fontName = defaultFont
+		font, err = ar.fontMgr.getFont(defaultFont)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	// Slice holding the chars
-	chars := []*asciiChar{}
+	input := applyControls(str, opt.Controls)
+
+	// Slice holding the chars and the runes they were created from
+	chars := make([]asciiChar, 0, len(input))
+	runes := make([]rune, 0, len(input))
 
 	// Index of the current color
 	curColorIndex := 0
 
 	// Foreach char create the ascii char
-	for _, char := range str {
+	for _, char := range input {
+		// Zero width chars (f.e. combining marks or joiners) take
+		// no column of the input, skip them if the font has no glyph
+		if _, ok := font.glyphs[char]; !ok && isZeroWidth(char) {
+			continue
+		}
+
 		// AsciiChar
 		asciiChar, err := newAsciiChar(font, char)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		// Set color if given
@@ -87,26 +257,90 @@ func (ar *AsciiRender) RenderOpts(str string, opt *RenderOptions) (string, error
 
 		// Append the char to the chars slice
 		chars = append(chars, asciiChar)
+		runes = append(runes, char)
+	}
+
+	b := &banner{
+		text:     str,
+		fontName: fontName,
+		height:   font.Height,
+		rows:     [][]asciiChar{chars},
+	}
+	mode := opt.Layout.smushMode(font)
+	if opt.Width > 0 {
+		var m rowMeasure = &fullWidth{}
+		if mode != 0 {
+			m = newSmusher(font, mode)
+		}
+		b.rows = wrapChars(chars, runes, opt.Width, m)
+	}
+
+	// Fit the chars of each row together
+	if mode != 0 {
+		s := newSmusher(font, mode)
+		for i, row := range b.rows {
+			s.reset()
+			for _, char := range row {
+				s.add(char)
+			}
+			b.rows[i] = s.row()
+		}
 	}
+	return b, nil
+}
+
+// Measures the width of a row while its chars are added
+type rowMeasure interface {
+	// Start a new row
+	reset()
+	// Add the next char of the row, returns the width of the row
+	add(char asciiChar) int
+}
+
+// Measures rows of chars with their full width
+type fullWidth struct {
+	width int
+}
 
-	// Result which will be returned
-	result := ""
+func (m *fullWidth) reset() {
+	m.width = 0
+}
 
-	result += opt.Parser.Prefix
+func (m *fullWidth) add(char asciiChar) int {
+	m.width += char.Width
+	return m.width
+}
 
-	// Foreach line of the font height
-	for curLine := 0; curLine < font.height; curLine++ {
-		result += opt.Parser.LinePrefix
+// Wrap chars into rows not wider than width columns
+// Rows are broken at the last space, which is dropped, or before
+// the char which doesn't fit if the row has no space
+// A single char wider than width gets a row of its own
+// The width of the rows is measured by m, chars may be smushed
+func wrapChars(chars []asciiChar, runes []rune, width int, m rowMeasure) [][]asciiChar {
+	rows := [][]asciiChar{}
 
-		// Add the current line of the char to the result
-		for i := range chars {
-			result += chars[i].GetLine(curLine, opt.Parser)
+	// Start of the current row and the index of its last space
+	start, space := 0, -1
+	m.reset()
+
+	for i := 0; i < len(chars); i++ {
+		if runes[i] == ' ' {
+			space = i
 		}
 
-		result += opt.Parser.LineSuffix
-	}
+		if m.add(chars[i]) > width && i > start {
+			end, next := i, i
+			if space > start {
+				end, next = space, space+1
+			}
+			rows = append(rows, chars[start:end])
 
-	result += opt.Parser.Suffix
+			// Go on after the break
+			start, space = next, -1
+			m.reset()
+			i = next - 1
+		}
+	}
 
-	return result, nil
+	return append(rows, chars[start:])
 }
@@ -0,0 +1,495 @@
+package figlet4go
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// Copy the builtin fonts into a temp dir under new names
+// so they have to be loaded lazily from disk
+func writeLazyFonts(t testing.TB) (string, []string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	names := []string{}
+
+	for _, name := range defaultFonts {
+		data, err := os.ReadFile(filepath.Join("assets", name+"."+extension))
+		if err != nil {
+			t.Fatal(err)
+		}
+		lazyName := "lazy" + name
+		err = os.WriteFile(filepath.Join(dir, lazyName+"."+extension), data, 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, lazyName)
+	}
+
+	return dir, names
+}
+
+func TestRenderOptsConcurrentLazyLoad(t *testing.T) {
+	dir, names := writeLazyFonts(t)
+
+	ascii := NewAsciiRender()
+	if err := ascii.LoadFont(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			opt := NewRenderOptions()
+			opt.FontName = names[i%len(names)]
+			if _, err := ascii.RenderOpts("Hello", opt); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, name := range names {
+		ascii.fontMgr.mu.RLock()
+		_, ok := ascii.fontMgr.fontLib[name]
+		ascii.fontMgr.mu.RUnlock()
+		if !ok {
+			t.Errorf("font %q was not loaded", name)
+		}
+	}
+}
+
+func TestRenderOptsConcurrentSameOutput(t *testing.T) {
+	dir, names := writeLazyFonts(t)
+
+	ascii := NewAsciiRender()
+	if err := ascii.LoadFont(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reference output from a separate renderer
+	ref := NewAsciiRender()
+	if err := ref.LoadFont(dir); err != nil {
+		t.Fatal(err)
+	}
+	opt := NewRenderOptions()
+	opt.FontName = names[0]
+	want, err := ref.RenderOpts("figlet4go", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			got, err := ascii.RenderOpts("figlet4go", opt)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if got != want {
+				t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+			}
+		}()
+		// Register fonts while rendering
+		go func() {
+			defer wg.Done()
+			if err := ascii.LoadFont(dir); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Filesystem blocking the reads of font files until release is closed
+type blockingFS struct {
+	fstest.MapFS
+	started chan struct{}
+	release chan struct{}
+	reads   atomic.Int32
+}
+
+func (b *blockingFS) ReadFile(name string) ([]byte, error) {
+	if b.reads.Add(1) == 1 {
+		close(b.started)
+	}
+	<-b.release
+	return b.MapFS.ReadFile(name)
+}
+
+func TestRenderOptsSlowFontLoad(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("assets", "standard."+extension))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := &blockingFS{
+		MapFS:   fstest.MapFS{"slow.flf": {Data: data}},
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+
+	ascii := NewAsciiRender()
+	if err := ascii.LoadFontFS(fsys, "."); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			opt := NewRenderOptions()
+			opt.FontName = "slow"
+			if _, err := ascii.RenderOpts("Hi", opt); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	<-fsys.started
+
+	// Loaded fonts don't wait for the slow font
+	done := make(chan error, 1)
+	go func() {
+		_, err := ascii.Render("Hi")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("rendering waits for a font being loaded")
+	}
+
+	close(fsys.release)
+	wg.Wait()
+	if reads := fsys.reads.Load(); reads != 1 {
+		t.Errorf("the font was read %d times", reads)
+	}
+}
+
+func TestLoadFontFS(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("assets", "larry3d."+extension))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"fonts/nested/fsfont.flf": {Data: data},
+		"fonts/readme.txt":        {Data: []byte("not a font")},
+	}
+
+	ascii := NewAsciiRender()
+	if err := ascii.LoadFontFS(fsys, "fonts"); err != nil {
+		t.Fatal(err)
+	}
+
+	opt := NewRenderOptions()
+	opt.FontName = "larry3d"
+	want, err := ascii.RenderOpts("fs", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opt.FontName = "fsfont"
+	got, err := ascii.RenderOpts("fs", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestLoadZippedFont(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("assets", "standard."+extension))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Compress the font like figlet's zipped font collections
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("standard." + extension)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	err = os.WriteFile(filepath.Join(dir, "zipped."+extension), buf.Bytes(), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ascii := NewAsciiRender()
+	if err := ascii.LoadFont(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := ascii.LoadBindataFont(buf.Bytes(), "zippedbindata"); err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := ascii.Render("zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"zipped", "zippedbindata"} {
+		opt := NewRenderOptions()
+		opt.FontName = name
+		got, err := ascii.RenderOpts("zip", opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%s: unexpected output:\n%s\nwant:\n%s", name, got, want)
+		}
+	}
+}
+
+func TestLoadZippedFontTooLarge(t *testing.T) {
+	// A zip bomb, a few KB expanding beyond the size limit
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("bomb." + extension)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("flf2a$ 1 1 1 -1 0\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(make([]byte, maxUnzippedSize)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewAsciiRender().LoadBindataFont(buf.Bytes(), "bomb"); err == nil {
+		t.Error("expected an error for a too large font")
+	}
+	diags, err := Validate(bytes.NewReader(buf.Bytes()))
+	if err != nil || len(diags) != 1 {
+		t.Errorf("unexpected diagnostics %v, error %v", diags, err)
+	}
+}
+
+func TestRenderCharNotInFont(t *testing.T) {
+	_, err := NewAsciiRender().Render("a\nb")
+
+	var notInFont *ErrCharNotInFont
+	if !errors.As(err, &notInFont) {
+		t.Fatalf("expected ErrCharNotInFont, got %v", err)
+	}
+	if notInFont.Char != '\n' {
+		t.Errorf("unexpected char %q", notInFont.Char)
+	}
+}
+
+func TestRenderOptsFontNotFound(t *testing.T) {
+	ascii := NewAsciiRender()
+
+	opt := NewRenderOptions()
+	opt.FontName = "standrad"
+	_, err := ascii.RenderOpts("typo", opt)
+
+	var notFound *ErrFontNotFound
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected ErrFontNotFound, got %v", err)
+	}
+	if notFound.Name != "standrad" {
+		t.Errorf("unexpected name %q", notFound.Name)
+	}
+	if len(notFound.Suggestions) == 0 || notFound.Suggestions[0] != "standard" {
+		t.Errorf("expected suggestion standard, got %v", notFound.Suggestions)
+	}
+
+	// Fallback to the default font
+	opt.Fallback = true
+	got, err := ascii.RenderOpts("typo", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ascii.Render("typo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFonts(t *testing.T) {
+	dir, names := writeLazyFonts(t)
+
+	ascii := NewAsciiRender()
+	if err := ascii.LoadFont(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := ascii.LoadFontFS(fstest.MapFS{"mapped.flf": {}}, "."); err != nil {
+		t.Fatal(err)
+	}
+
+	// Load one of the disk fonts
+	opt := NewRenderOptions()
+	opt.FontName = names[0]
+	if _, err := ascii.RenderOpts("x", opt); err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]FontInfo)
+	for _, f := range ascii.Fonts() {
+		got[f.Name] = f
+	}
+
+	want := []FontInfo{
+		{"standard", FontSourceBuiltin, "assets/standard.flf", true, 6},
+		{"larry3d", FontSourceBuiltin, "assets/larry3d.flf", true, 9},
+		{names[0], FontSourceDisk, filepath.Join(dir, names[0]+"."+extension), true, 6},
+		{names[1], FontSourceDisk, filepath.Join(dir, names[1]+"."+extension), false, 0},
+		{"mapped", FontSourceFS, "mapped.flf", false, 0},
+	}
+	if len(got) != len(want) {
+		t.Errorf("expected %d fonts, got %v", len(want), got)
+	}
+	for _, w := range want {
+		if got[w.Name] != w {
+			t.Errorf("expected %+v, got %+v", w, got[w.Name])
+		}
+	}
+
+	// FontInfo loads the font
+	info, err := ascii.FontInfo(names[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.Loaded || info.Height != 9 {
+		t.Errorf("expected loaded font with height 9, got %+v", info)
+	}
+}
+
+func TestPreview(t *testing.T) {
+	ascii := NewAsciiRender()
+	fsys := fstest.MapFS{"broken.flf": {Data: []byte(zipSignature + "not a zip")}}
+	if err := ascii.LoadFontFS(fsys, "."); err != nil {
+		t.Fatal(err)
+	}
+
+	previews := ascii.Preview("", NewRenderOptions())
+	if len(previews) != 3 {
+		t.Fatalf("expected 3 previews, got %d", len(previews))
+	}
+
+	for _, p := range previews {
+		if p.Font.Name == "broken" {
+			if p.Err == nil {
+				t.Error("expected an error for the broken font")
+			}
+			continue
+		}
+		if p.Err != nil {
+			t.Errorf("%s: %v", p.Font.Name, p.Err)
+		}
+
+		opt := NewRenderOptions()
+		opt.FontName = p.Font.Name
+		want, _ := ascii.RenderOpts(p.Font.Name, opt)
+		if p.Output != want {
+			t.Errorf("%s: unexpected output:\n%s\nwant:\n%s", p.Font.Name, p.Output, want)
+		}
+		if p.Height == 0 {
+			t.Errorf("%s: height not set", p.Font.Name)
+		}
+	}
+}
+
+func TestRenderOptsWidth(t *testing.T) {
+	ascii := NewAsciiRender()
+	render := func(str string, width int) string {
+		opt := NewRenderOptions()
+		opt.Width = width
+		out, err := ascii.RenderOpts(str, opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	ab, cd := render("ab", 0), render("cd", 0)
+	width := len(strings.SplitN(ab, "\n", 2)[0])
+
+	// Broken at the space, which is dropped
+	if got := render("ab cd", width+1); got != ab+cd {
+		t.Errorf("unexpected output for a break at the space:\n%s\nwant:\n%s", got, ab+cd)
+	}
+	// Broken before the char which doesn't fit
+	if got := render("abcd", width); got != ab+cd {
+		t.Errorf("unexpected output for a break without space:\n%s\nwant:\n%s", got, ab+cd)
+	}
+	// Too narrow for a single char
+	if got, want := render("ab", 1), render("a", 0)+render("b", 0); got != want {
+		t.Errorf("unexpected output for a narrow width:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// Render a line of text with the builtin fonts, reports allocations per render
+func benchmarkRender(b *testing.B, font, parser string, colors []Color, layout Layout) {
+	ascii := NewAsciiRender()
+	opt := NewRenderOptions()
+	opt.FontName = font
+	opt.Parser = getParser(b, parser)
+	opt.FontColor = colors
+	opt.Layout = layout
+
+	// Load the font before measuring
+	if _, err := ascii.RenderOpts("x", opt); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := ascii.RenderOpts("The quick brown fox jumps over the lazy dog", opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRenderStandard(b *testing.B) {
+	benchmarkRender(b, "standard", "terminal", nil, LayoutFullWidth)
+}
+
+func BenchmarkRenderLarry3d(b *testing.B) {
+	benchmarkRender(b, "larry3d", "terminal", nil, LayoutFullWidth)
+}
+
+func BenchmarkRenderSmushed(b *testing.B) {
+	benchmarkRender(b, "standard", "terminal", nil, LayoutFont)
+}
+
+func BenchmarkRenderColors(b *testing.B) {
+	benchmarkRender(b, "standard", "terminal", []Color{ColorRed, TrueColor{0, 255, 0}}, LayoutFullWidth)
+}
+
+func BenchmarkRenderHTML(b *testing.B) {
+	benchmarkRender(b, "standard", "html", []Color{ColorRed, TrueColor{0, 255, 0}}, LayoutFullWidth)
+}
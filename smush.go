@@ -0,0 +1,306 @@
+package figlet4go
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Layout sets how the chars of a line are fitted together
+type Layout int
+
+const (
+	// Every char keeps its full width (figlet -W)
+	LayoutFullWidth Layout = iota
+	// The layout the font was designed for, the default of figlet (figlet -s)
+	LayoutFont
+	// Chars are moved together until they touch (figlet -k)
+	LayoutKerning
+	// Chars are smushed with the rules of the font, or overlapped
+	// if the font has no rules (figlet -S)
+	LayoutSmushing
+	// Chars are moved together until they touch and then one column
+	// further, the overlapping chars are replaced (figlet -o)
+	LayoutOverlapping
+)
+
+// Horizontal smushing rules and modes, the bits of the full layout
+const (
+	smushEqual     int = 1
+	smushLowline   int = 2
+	smushHierarchy int = 4
+	smushPair      int = 8
+	smushBigX      int = 16
+	smushHardblank int = 32
+	smushKern      int = 64
+	smushSmush     int = 128
+)
+
+// Classes of the hierarchy rule, a char of a higher class
+// replaces a char of a lower one
+var smushClasses = []string{"|", "/\\", "[]", "{}", "()", "<>"}
+
+// Get the horizontal smush mode of the layout for a font
+// Works like the smushmode of figlet
+func (l Layout) smushMode(font *Font) int {
+	fontMode := font.FullLayout & 255
+	switch l {
+	case LayoutFont:
+		return fontMode
+	case LayoutKerning:
+		return smushKern
+	case LayoutSmushing:
+		return smushSmush | fontMode
+	case LayoutOverlapping:
+		return smushSmush
+	}
+	return 0
+}
+
+// Fits the chars of a row together like figlet does
+// The lines are built char by char, every char owns the columns
+// it added to the lines, smushed columns stay with the char before
+type smusher struct {
+	mode      int
+	hardblank rune
+	// Lines of the row, hardblanks are kept
+	lines [][]rune
+	// Width of each line in display columns
+	widths []int
+	// The chars of the row with the column of each line they start
+	// at, the columns of all lines of a char follow each other
+	chars  []asciiChar
+	starts []int
+	// Width of the last char (previouscharwidth of figlet)
+	prevWidth int
+}
+
+// Create a smusher for the chars of a font
+func newSmusher(font *Font, mode int) *smusher {
+	hardblank, _ := utf8.DecodeRuneInString(font.Hardblank)
+	return &smusher{
+		mode:      mode,
+		hardblank: hardblank,
+		lines:     make([][]rune, font.Height),
+		widths:    make([]int, font.Height),
+	}
+}
+
+// Start a new row
+func (s *smusher) reset() {
+	for i := range s.lines {
+		s.lines[i] = nil
+		s.widths[i] = 0
+	}
+	s.chars = nil
+	s.starts = nil
+	s.prevWidth = 0
+}
+
+// Add the next char of the row, returns the width of the row
+// Blanks are a single column and at least one char of each overlapped
+// pair is a blank unless both are single columns, so every row loses
+// the same number of display columns
+func (s *smusher) add(char asciiChar) int {
+	g := char.Glyph
+	width := g.width
+	amount := s.amount(g, width)
+
+	rowWidth := 0
+	for row, line := range s.lines {
+		cells := g.cells[row]
+		for k := 0; k < amount && k < len(cells); k++ {
+			if col := len(line) - amount + k; col >= 0 {
+				smushed := s.smush(line[col], cells[k], width)
+				s.widths[row] += runeWidth(smushed) - runeWidth(line[col])
+				line[col] = smushed
+			}
+		}
+		s.starts = append(s.starts, len(line))
+		if amount < len(cells) {
+			line = append(line, cells[amount:]...)
+			for _, r := range cells[amount:] {
+				s.widths[row] += runeWidth(r)
+			}
+		}
+		s.lines[row] = line
+		rowWidth = max(rowWidth, s.widths[row])
+	}
+
+	s.chars = append(s.chars, char)
+	s.prevWidth = width
+	return rowWidth
+}
+
+// Get the number of columns the next char can be moved into
+// the row (smushamt of figlet)
+func (s *smusher) amount(g *glyph, width int) int {
+	if s.mode&(smushSmush|smushKern) == 0 {
+		return 0
+	}
+
+	maxAmount := width
+	for row, line := range s.lines {
+		// Last column of the row which isn't blank, the first one if all are
+		lineEnd := len(line) - 1
+		for lineEnd > 0 && line[lineEnd] == ' ' {
+			lineEnd--
+		}
+		var last rune
+		if lineEnd >= 0 {
+			last = line[lineEnd]
+		} else {
+			lineEnd = 0
+		}
+
+		// First column of the char which isn't blank
+		charStart := g.left[row]
+		var first rune
+		if charStart < len(g.cells[row]) {
+			first = g.cells[row][charStart]
+		}
+
+		amount := charStart + len(line) - 1 - lineEnd
+		if last == 0 || last == ' ' || (first != 0 && s.smush(last, first, width) != 0) {
+			amount++
+		}
+		maxAmount = min(maxAmount, amount)
+	}
+	return maxAmount
+}
+
+// Smush two chars into one, 0 if they can't be smushed (smushem of figlet)
+func (s *smusher) smush(left, right rune, width int) rune {
+	if left == ' ' {
+		return right
+	}
+	if right == ' ' {
+		return left
+	}
+	// Chars with a width of 1 or less are never overlapped
+	if s.prevWidth < 2 || width < 2 {
+		return 0
+	}
+	// Wide and zero width cells are only kerned, replacing them by a
+	// single column would shift the rest of their line
+	if runeWidth(left) != 1 || runeWidth(right) != 1 {
+		return 0
+	}
+	if s.mode&smushSmush == 0 {
+		return 0
+	}
+
+	// Overlapping without rules, the right char wins
+	if s.mode&63 == 0 {
+		if left == s.hardblank {
+			return right
+		}
+		if right == s.hardblank {
+			return left
+		}
+		return right
+	}
+
+	if s.mode&smushHardblank != 0 && left == s.hardblank && right == s.hardblank {
+		return left
+	}
+	if left == s.hardblank || right == s.hardblank {
+		return 0
+	}
+
+	if s.mode&smushEqual != 0 && left == right {
+		return left
+	}
+	if s.mode&smushLowline != 0 {
+		if left == '_' && strings.ContainsRune("|/\\[]{}()<>", right) {
+			return right
+		}
+		if right == '_' && strings.ContainsRune("|/\\[]{}()<>", left) {
+			return left
+		}
+	}
+	if s.mode&smushHierarchy != 0 {
+		lc, rc := smushClass(left), smushClass(right)
+		if lc >= 0 && rc >= 0 && lc != rc {
+			if lc > rc {
+				return left
+			}
+			return right
+		}
+	}
+	if s.mode&smushPair != 0 {
+		switch [2]rune{left, right} {
+		case [2]rune{'[', ']'}, [2]rune{']', '['}, [2]rune{'{', '}'},
+			[2]rune{'}', '{'}, [2]rune{'(', ')'}, [2]rune{')', '('}:
+			return '|'
+		}
+	}
+	if s.mode&smushBigX != 0 {
+		switch [2]rune{left, right} {
+		case [2]rune{'/', '\\'}:
+			return '|'
+		case [2]rune{'\\', '/'}:
+			return 'Y'
+		case [2]rune{'>', '<'}:
+			return 'X'
+		}
+	}
+	return 0
+}
+
+// Get the class of a char for the hierarchy rule, -1 for none
+func smushClass(char rune) int {
+	for i, class := range smushClasses {
+		if strings.ContainsRune(class, char) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Get the chars of the row with the lines they own, hardblanks are
+// replaced by spaces
+func (s *smusher) row() []asciiChar {
+	height := len(s.lines)
+	chars := make([]asciiChar, len(s.chars))
+	lines := make([]string, len(s.chars)*height)
+	for i := range chars {
+		chars[i] = s.chars[i]
+		chars[i].Lines = lines[i*height : (i+1)*height]
+		chars[i].Width = 0
+	}
+
+	for row, line := range s.lines {
+		for col, r := range line {
+			if r == s.hardblank {
+				line[col] = ' '
+			}
+		}
+		str := string(line)
+
+		// Cut the line at the byte offsets of the start columns
+		i, offset, start := 0, 0, 0
+		for col, r := range line {
+			for i < len(chars) && s.starts[i*height+row] == col {
+				if i > 0 {
+					chars[i-1].Lines[row] = str[start:offset]
+				}
+				start = offset
+				i++
+			}
+			offset += utf8.RuneLen(r)
+		}
+		for ; i <= len(chars); i++ {
+			if i > 0 {
+				chars[i-1].Lines[row] = str[start:offset]
+			}
+			start = offset
+		}
+	}
+
+	for i := range chars {
+		for _, line := range chars[i].Lines {
+			chars[i].Width = max(chars[i].Width, stringWidth(line))
+		}
+	}
+	return chars
+}
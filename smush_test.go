@@ -0,0 +1,156 @@
+package figlet4go
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSmushRules(t *testing.T) {
+	tests := []struct {
+		mode        int
+		left, right rune
+		want        rune
+	}{
+		{smushSmush | smushEqual, '|', '|', '|'},
+		{smushSmush | smushEqual, '|', '/', 0},
+		{smushSmush | smushLowline, '_', '/', '/'},
+		{smushSmush | smushLowline, '(', '_', '('},
+		{smushSmush | smushHierarchy, '|', '/', '/'},
+		{smushSmush | smushHierarchy, '<', '[', '<'},
+		{smushSmush | smushHierarchy, '/', '\\', 0},
+		{smushSmush | smushPair, '[', ']', '|'},
+		{smushSmush | smushPair, ')', '(', '|'},
+		{smushSmush | smushBigX, '/', '\\', '|'},
+		{smushSmush | smushBigX, '\\', '/', 'Y'},
+		{smushSmush | smushBigX, '>', '<', 'X'},
+		{smushSmush | smushHardblank, '$', '$', '$'},
+		{smushSmush | smushEqual, '$', '$', 0},
+		// Blanks are always replaced
+		{smushSmush | smushEqual, ' ', 'x', 'x'},
+		{smushKern, 'x', ' ', 'x'},
+		// Kerning never overlaps
+		{smushKern, 'x', 'x', 0},
+		// Overlapping without rules, hardblanks are replaced
+		{smushSmush, 'x', 'y', 'y'},
+		{smushSmush, 'x', '$', 'x'},
+	}
+
+	for _, test := range tests {
+		s := &smusher{mode: test.mode, hardblank: '$', prevWidth: 2}
+		if got := s.smush(test.left, test.right, 2); got != test.want {
+			t.Errorf("mode %d %q %q: got %q, want %q", test.mode, test.left, test.right, got, test.want)
+		}
+	}
+
+	// Chars with a width of 1 are never smushed
+	s := &smusher{mode: smushSmush | smushEqual, hardblank: '$', prevWidth: 1}
+	if got := s.smush('|', '|', 2); got != 0 {
+		t.Errorf("got %q for a narrow char", got)
+	}
+}
+
+func TestRenderLayout(t *testing.T) {
+	ascii := NewAsciiRender()
+
+	render := func(text string, layout Layout, width int) string {
+		t.Helper()
+		opt := NewRenderOptions()
+		opt.Layout = layout
+		opt.Width = width
+		out, err := ascii.RenderOpts(text, opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	// The output of figlet with its default layout
+	want := strings.Join([]string{
+		` _   _      _ _         __        __         _     _ _ `,
+		`| | | | ___| | | ___    \ \      / /__  _ __| | __| | |`,
+		`| |_| |/ _ \ | |/ _ \    \ \ /\ / / _ \| '__| |/ _` + "`" + ` | |`,
+		`|  _  |  __/ | | (_) |    \ V  V / (_) | |  | | (_| |_|`,
+		`|_| |_|\___|_|_|\___( )    \_/\_/ \___/|_|  |_|\__,_(_)`,
+		`                    |/                                 `,
+	}, "\n") + "\n"
+	if got := render("Hello, World!", LayoutFont, 0); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Kerned chars touch, but don't overlap
+	want = strings.Join([]string{
+		` _   _        _  _        `,
+		`| | | |  ___ | || |  ___  `,
+		`| |_| | / _ \| || | / _ \ `,
+		`|  _  ||  __/| || || (_) |`,
+		`|_| |_| \___||_||_| \___/ `,
+		`                          `,
+	}, "\n") + "\n"
+	if got := render("Hello", LayoutKerning, 0); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Rows are wrapped by their smushed width
+	full := render("Hello World", LayoutFullWidth, 0)
+	smushed := render("Hello World", LayoutFont, 0)
+	width := len(strings.SplitN(smushed, "\n", 2)[0])
+	if width >= len(strings.SplitN(full, "\n", 2)[0]) {
+		t.Fatalf("smushed output isn't narrower:\n%s", smushed)
+	}
+	if got := render("Hello World", LayoutFont, width); got != smushed {
+		t.Errorf("wrapped although the smushed output fits:\n%s", got)
+	}
+}
+
+func TestRenderLayoutWide(t *testing.T) {
+	// Chars of a font without smushing rules, "a" ends with a wide
+	// char in its first line
+	font := NewFont(2)
+	font.FullLayout = smushSmush
+	chars := map[rune][]string{
+		'a': {"|漢", "|||"},
+		'b': {"|x", "|x"},
+		'c': {"漢 ", " 漢"},
+	}
+	for code, lines := range chars {
+		if err := font.SetChar(code, lines, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var flf strings.Builder
+	if err := font.WriteFLF(&flf); err != nil {
+		t.Fatal(err)
+	}
+	ascii := NewAsciiRender()
+	if err := ascii.LoadBindataFont([]byte(flf.String()), "wide"); err != nil {
+		t.Fatal(err)
+	}
+
+	render := func(text string, layout Layout) string {
+		t.Helper()
+		opt := NewRenderOptions()
+		opt.FontName = "wide"
+		opt.Layout = layout
+		out, err := ascii.RenderOpts(text, opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	// The wide char isn't overlapped, the narrow chars below it would be
+	if got, want := render("ab", LayoutOverlapping), "|漢|x\n||||x\n"; got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	// All lines keep the same display width
+	for _, layout := range []Layout{LayoutFont, LayoutKerning, LayoutSmushing, LayoutOverlapping} {
+		for _, text := range []string{"ab", "cb", "abcacb"} {
+			out := render(text, layout)
+			lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+			if stringWidth(lines[0]) != stringWidth(lines[1]) {
+				t.Errorf("layout %d: lines of different widths:\n%s", layout, out)
+			}
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package figlet4go
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Diagnostic is a problem in a font file found by Validate
+type Diagnostic struct {
+	// Line of the font file starting at 1, 0 for the whole file
+	Line int
+	// Description of the problem
+	Message string
+}
+
+// String returns the diagnostic as "line: message"
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%d: %s", d.Line, d.Message)
+}
+
+// Collects the diagnostics of a font file
+type validator struct {
+	diags []Diagnostic
+}
+
+// Add a diagnostic for a line (0-indexed)
+func (v *validator) report(index int, format string, args ...interface{}) {
+	v.diags = append(v.diags, Diagnostic{index + 1, fmt.Sprintf(format, args...)})
+}
+
+// Validate checks a FIGfont or TOIlet font for problems which would break
+// rendering: an inconsistent header, chars with the wrong number of lines,
+// lines of different width within a char, missing required chars,
+// duplicate code tags and lines longer than the max length
+// The error is only set if the font couldn't be read
+func Validate(r io.Reader) ([]Diagnostic, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	cont := string(data)
+
+	// Decompress zipped fonts first
+	if strings.HasPrefix(cont, zipSignature) {
+		cont, err = unzipFontContent(cont)
+		if err != nil {
+			return []Diagnostic{{0, err.Error()}}, nil
+		}
+	}
+
+	v := &validator{}
+	v.validate(strings.Split(strings.TrimSuffix(cont, "\n"), "\n"))
+	return v.diags, nil
+}
+
+// Check all lines of a font
+func (v *validator) validate(lines []string) {
+	header, ok := v.validateHeader(lines)
+	if !ok {
+		return
+	}
+
+	cur := 1 + header.commentLines
+	for _, code := range requiredChars {
+		if cur >= len(lines) {
+			v.report(len(lines)-1, "missing required char %U and following", code)
+			return
+		}
+		cur = v.validateChar(lines, cur, header, code)
+	}
+
+	// Code tagged chars
+	tags := make(map[rune]int)
+	for cur < len(lines) {
+		if strings.TrimSpace(lines[cur]) == "" {
+			cur++
+			continue
+		}
+
+		code, _, ok := parseCodeTag(lines[cur])
+		switch {
+		case !ok:
+			v.report(cur, "invalid code tag %q", lines[cur])
+			return
+		case code == -1:
+			v.report(cur, "code tag -1 is not allowed")
+		}
+		if first, dup := tags[code]; dup {
+			v.report(cur, "duplicate code tag %U, first defined in line %d", code, first+1)
+		}
+		tags[code] = cur
+
+		cur = v.validateChar(lines, cur+1, header, code)
+	}
+
+	if header.codetagCount >= 0 && header.codetagCount != len(tags) {
+		v.report(0, "header declares %d code tagged chars, found %d", header.codetagCount, len(tags))
+	}
+}
+
+// Header values needed to check the chars
+type validatedHeader struct {
+	hardblank    string
+	height       int
+	maxLength    int
+	commentLines int
+	// -1 if the header doesn't declare it
+	codetagCount int
+}
+
+// Check the header line, ok is false if the chars can't be checked
+func (v *validator) validateHeader(lines []string) (validatedHeader, bool) {
+	h := validatedHeader{codetagCount: -1}
+	line := strings.TrimRight(lines[0], "\r")
+
+	if !strings.HasPrefix(line, signature) && !strings.HasPrefix(line, toiletSignature) {
+		v.report(0, "invalid signature, expected %q or %q", signature, toiletSignature)
+		return h, false
+	}
+	hardblank, size := utf8.DecodeRuneInString(line[len(signature):])
+	if size == 0 || hardblank == ' ' {
+		v.report(0, "missing hardblank")
+		return h, false
+	}
+	h.hardblank = string(hardblank)
+
+	names := []string{"height", "baseline", "max length", "old layout", "comment lines", "print direction", "full layout", "codetag count"}
+	fields := strings.Fields(line[len(signature)+size:])
+	if len(fields) < 5 {
+		v.report(0, "header has %d of the 5 required values", len(fields))
+		return h, false
+	}
+	if len(fields) > len(names) {
+		v.report(0, "header has %d values, expected at most %d", len(fields), len(names))
+	}
+
+	nums := make([]int, len(names))
+	for i := range names {
+		if i >= len(fields) {
+			nums[i] = -1
+			continue
+		}
+		num, err := strconv.Atoi(fields[i])
+		if err != nil {
+			v.report(0, "%s is not a number: %q", names[i], fields[i])
+			return h, false
+		}
+		nums[i] = num
+	}
+	height, baseline, maxLength, oldLayout, commentLines := nums[0], nums[1], nums[2], nums[3], nums[4]
+	printDirection, fullLayout := nums[5], nums[6]
+
+	if height < 1 {
+		v.report(0, "height must be at least 1, is %d", height)
+		return h, false
+	}
+	if baseline < 1 || baseline > height {
+		v.report(0, "baseline must be between 1 and the height %d, is %d", height, baseline)
+	}
+	if maxLength < 1 {
+		v.report(0, "max length must be at least 1, is %d", maxLength)
+	}
+	if oldLayout < -1 || oldLayout > 63 {
+		v.report(0, "old layout must be between -1 and 63, is %d", oldLayout)
+	}
+	if commentLines < 0 || 1+commentLines > len(lines) {
+		v.report(0, "comment lines %d exceed the file", commentLines)
+		return h, false
+	}
+	if len(fields) > 5 && printDirection != 0 && printDirection != 1 {
+		v.report(0, "print direction must be 0 or 1, is %d", printDirection)
+	}
+	if len(fields) > 6 {
+		switch {
+		case fullLayout < 0 || fullLayout > 32767:
+			v.report(0, "full layout must be between 0 and 32767, is %d", fullLayout)
+		case oldLayout > 0 && fullLayout&63 != oldLayout:
+			v.report(0, "full layout %d doesn't match the smushing rules of old layout %d", fullLayout, oldLayout)
+		case oldLayout == 0 && fullLayout&(64|128) != 64:
+			v.report(0, "full layout %d doesn't match kerning of old layout 0", fullLayout)
+		case oldLayout == -1 && fullLayout&(64|128) != 0:
+			v.report(0, "full layout %d doesn't match full width of old layout -1", fullLayout)
+		}
+	}
+
+	h.height = height
+	h.maxLength = maxLength
+	h.commentLines = commentLines
+	h.codetagCount = nums[7]
+	return h, true
+}
+
+// Check a char starting at the line index begin
+// Returns the index of the line following the char
+func (v *validator) validateChar(lines []string, begin int, h validatedHeader, code rune) int {
+	width := -1
+
+	for i := 0; i < h.height; i++ {
+		index := begin + i
+		if index >= len(lines) {
+			v.report(len(lines)-1, "char %U has %d lines, expected %d", code, i, h.height)
+			return index
+		}
+
+		line := strings.TrimRight(lines[index], " \t\r")
+		if line == "" {
+			v.report(index, "char %U: missing endmark", code)
+			continue
+		}
+
+		if length := utf8.RuneCountInString(line); length > h.maxLength {
+			v.report(index, "char %U: line length %d exceeds the max length %d", code, length, h.maxLength)
+		}
+
+		// Count the endmarks
+		endmark, _ := utf8.DecodeLastRuneInString(line)
+		content := strings.TrimRight(line, string(endmark))
+		endmarks := utf8.RuneCountInString(line) - utf8.RuneCountInString(content)
+
+		last := i == h.height-1
+		if !last && endmarks > 1 {
+			// Continue with the next char after the early end
+			v.report(index, "char %U has %d lines, expected %d", code, i+1, h.height)
+			return index + 1
+		}
+		if last && endmarks < 2 {
+			v.report(index, "char %U: last line should end with two endmarks", code)
+		}
+
+		lineWidth := stringWidth(strings.Replace(content, h.hardblank, " ", -1))
+		if width >= 0 && lineWidth != width {
+			v.report(index, "char %U: line width %d differs from the first line width %d", code, lineWidth, width)
+		}
+		if width < 0 {
+			width = lineWidth
+		}
+	}
+
+	return begin + h.height
+}
@@ -0,0 +1,78 @@
+package figlet4go
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateBuiltin(t *testing.T) {
+	for _, name := range []string{"standard", "larry3d"} {
+		f, err := os.Open(filepath.Join("assets", name+"."+extension))
+		if err != nil {
+			t.Fatal(err)
+		}
+		diags, err := Validate(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(diags) > 0 {
+			t.Errorf("%s: unexpected diagnostics %v", name, diags)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// 'A' and the CJK char have lines of different width,
+	// the last chars are longer than the max length
+	font := testToiletFont() + "0x2605  BLACK STAR\n★@\n★@@\n"
+	diags, err := Validate(strings.NewReader(font))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Diagnostic{
+		{70, "char U+0041: line width 2 differs from the first line width 3"},
+		{209, "char U+2605: line length 5 exceeds the max length 4"},
+		{212, "char U+4E2D: line length 7 exceeds the max length 4"},
+		{212, "char U+4E2D: line width 3 differs from the first line width 2"},
+		{213, "duplicate code tag U+2605, first defined in line 207"},
+	}
+	if !reflect.DeepEqual(diags, want) {
+		t.Errorf("got %v, want %v", diags, want)
+	}
+}
+
+func TestValidateBroken(t *testing.T) {
+	lines := []string{
+		"flf2a$ 2 3 4 0 1 0 0 2",
+		"broken font",
+		// Space with too few lines
+		" @@",
+		// '!' with a too long line and different widths
+		"!!@",
+		"!!!!@@",
+		// '"' with a single endmark on the last line
+		"\" @",
+		"\" @",
+	}
+	diags, err := Validate(strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Diagnostic{
+		{1, "baseline must be between 1 and the height 2, is 3"},
+		{1, "full layout 0 doesn't match kerning of old layout 0"},
+		{3, "char U+0020 has 1 lines, expected 2"},
+		{5, "char U+0021: line length 6 exceeds the max length 4"},
+		{5, "char U+0021: line width 4 differs from the first line width 2"},
+		{7, "char U+0022: last line should end with two endmarks"},
+		{7, "missing required char U+0023 and following"},
+	}
+	if !reflect.DeepEqual(diags, want) {
+		t.Errorf("got:\n%v\nwant:\n%v", diags, want)
+	}
+}
@@ -0,0 +1,35 @@
+package figlet4go
+
+import "testing"
+
+func TestRuneWidth(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want int
+	}{
+		{'a', 1},
+		{'█', 1},
+		{'é', 1},
+		{'\u0301', 0}, // combining acute accent
+		{'\u200d', 0}, // zero width joiner
+		{'\ufe0f', 0}, // variation selector
+		{'\t', 0},
+		{'中', 2},
+		{'ｱ', 1}, // halfwidth katakana
+		{'Ａ', 2}, // fullwidth latin
+		{'😀', 2},
+		{'★', 1},
+	}
+
+	for _, test := range tests {
+		if got := runeWidth(test.r); got != test.want {
+			t.Errorf("runeWidth(%U) = %d, want %d", test.r, got, test.want)
+		}
+	}
+}
+
+func TestStringWidth(t *testing.T) {
+	if got := stringWidth("é中 x"); got != 5 {
+		t.Errorf("expected width 5, got %d", got)
+	}
+}